@@ -7,4 +7,12 @@ output "account_email" {
 
 output "monitor_usage" {
   value = "${data.uptime_account.current.monitors_count}/${data.uptime_account.current.monitors_limit} monitors used"
-}
\ No newline at end of file
+}
+# Adapt monitors to what the current plan allows
+resource "uptime_monitor" "api" {
+  name           = "API"
+  type           = "https"
+  url            = "https://api.example.com/health"
+  check_interval = max(30, data.uptime_account.current.min_check_interval)
+  regions        = slice(data.uptime_account.current.allowed_regions, 0, min(3, data.uptime_account.current.max_regions_per_monitor, length(data.uptime_account.current.allowed_regions)))
+}
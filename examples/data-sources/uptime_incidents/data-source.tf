@@ -0,0 +1,20 @@
+# Resolved incidents of the checkout monitors during January
+data "uptime_incidents" "checkout_january" {
+  monitor_ids = [uptime_monitor.checkout_api.id, uptime_monitor.checkout_web.id]
+  from        = "2024-01-01T00:00:00Z"
+  to          = "2024-02-01T00:00:00Z"
+  status      = "resolved"
+}
+
+output "checkout_downtime_minutes" {
+  value = sum(concat([0], [for i in data.uptime_incidents.checkout_january.incidents : i.duration])) / 60
+}
+
+# Currently open incidents across the account
+data "uptime_incidents" "open" {
+  status = "open"
+}
+
+output "open_incidents" {
+  value = [for i in data.uptime_incidents.open.incidents : "${i.monitor_name}: ${i.cause}"]
+}
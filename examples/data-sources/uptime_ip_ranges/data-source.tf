@@ -0,0 +1,25 @@
+# Checker egress ranges for the regions a monitor uses
+data "uptime_ip_ranges" "api" {
+  regions = uptime_monitor.api.regions
+}
+
+# Allow checkers through an AWS security group
+resource "aws_security_group_rule" "uptime_checkers" {
+  type              = "ingress"
+  from_port         = 443
+  to_port           = 443
+  protocol          = "tcp"
+  cidr_blocks       = data.uptime_ip_ranges.api.ipv4_cidr_blocks
+  ipv6_cidr_blocks  = data.uptime_ip_ranges.api.ipv6_cidr_blocks
+  security_group_id = aws_security_group.api.id
+  description       = "Uptime checkers (${substr(data.uptime_ip_ranges.api.hash, 0, 12)})"
+}
+
+# Every region's ranges, e.g. for a Cloudflare IP list
+data "uptime_ip_ranges" "all" {}
+
+output "checker_ranges_by_region" {
+  value = {
+    for r in data.uptime_ip_ranges.all.ranges : r.region => concat(r.ipv4_cidr_blocks, r.ipv6_cidr_blocks)
+  }
+}
@@ -0,0 +1,25 @@
+# Uptime statistics of a monitor over the last 7 days
+data "uptime_monitor_stats" "api" {
+  monitor_id          = uptime_monitor.api.id
+  window              = "7d"
+  recent_checks_limit = 10
+}
+
+# Gate promotions on availability and latency
+check "api_slo" {
+  assert {
+    condition     = data.uptime_monitor_stats.api.uptime_percentage >= 99.9
+    error_message = "API uptime over the last 7 days is ${data.uptime_monitor_stats.api.uptime_percentage}%, below the 99.9% SLO."
+  }
+
+  assert {
+    condition     = data.uptime_monitor_stats.api.p95_response_time < 500
+    error_message = "API p95 response time is ${data.uptime_monitor_stats.api.p95_response_time}ms."
+  }
+}
+
+output "api_uptime_by_region" {
+  value = {
+    for r in data.uptime_monitor_stats.api.regions : r.region => r.uptime_percentage
+  }
+}
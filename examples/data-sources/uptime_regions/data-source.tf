@@ -0,0 +1,18 @@
+# List all check regions
+data "uptime_regions" "all" {}
+
+locals {
+  # Regions usable on the current plan that support IPv6 targets
+  ipv6_regions = [
+    for region in data.uptime_regions.all.regions : region.id
+    if region.available && region.ipv6
+  ]
+}
+
+resource "uptime_monitor" "ipv6_api" {
+  name           = "IPv6 API"
+  url            = "https://ipv6.example.com/health"
+  type           = "https"
+  regions        = local.ipv6_regions
+  fail_threshold = 2
+}
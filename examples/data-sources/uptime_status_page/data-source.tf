@@ -3,6 +3,16 @@ data "uptime_status_page" "example" {
   id = "abc123"
 }
 
+# Or look up a status page by name
+data "uptime_status_page" "by_name" {
+  name = "Public Status"
+}
+
+# Or look up a status page by its custom domain
+data "uptime_status_page" "by_domain" {
+  custom_domain = "status.example.com"
+}
+
 # Use the status page data
 output "status_page_name" {
   value = data.uptime_status_page.example.name
@@ -26,4 +36,4 @@ output "status_page_period" {
 
 output "show_incident_reasons" {
   value = data.uptime_status_page.example.show_incident_reasons
-}
\ No newline at end of file
+}
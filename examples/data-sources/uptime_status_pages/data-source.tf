@@ -0,0 +1,23 @@
+# List every status page in the account
+data "uptime_status_pages" "all" {}
+
+locals {
+  customer_domains = ["status.example.com", "status.example.org"]
+
+  status_page_domains = [
+    for page in data.uptime_status_pages.all.status_pages : page.custom_domain
+    if page.custom_domain != null
+  ]
+}
+
+# Fail the plan if a customer-facing domain has no status page
+check "every_domain_has_a_status_page" {
+  assert {
+    condition     = length(setsubtract(local.customer_domains, local.status_page_domains)) == 0
+    error_message = "Missing status pages for: ${join(", ", setsubtract(local.customer_domains, local.status_page_domains))}"
+  }
+}
+
+output "status_page_urls" {
+  value = { for page in data.uptime_status_pages.all.status_pages : page.name => page.url }
+}
@@ -0,0 +1,8 @@
+locals {
+  expected_status_codes = "200-204,301"
+}
+
+# [200, 201, 202, 203, 204, 301]
+output "accepted_codes" {
+  value = provider::uptime::expand_status_codes(local.expected_status_codes)
+}
@@ -0,0 +1,7 @@
+# "Accept: application/json\nX-Request-Source: uptime"
+output "wire_headers" {
+  value = provider::uptime::format_headers({
+    "X-Request-Source" = "uptime"
+    "Accept"           = "application/json"
+  })
+}
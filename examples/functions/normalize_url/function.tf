@@ -0,0 +1,4 @@
+# Compare a configured URL with the value stored in state
+output "normalized" {
+  value = provider::uptime::normalize_url("https://example.com/") # "https://example.com"
+}
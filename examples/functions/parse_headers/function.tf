@@ -0,0 +1,4 @@
+# { "Accept" = "application/json", "X-Api-Key" = "secret" }
+output "headers" {
+  value = provider::uptime::parse_headers("Accept: application/json\nX-Api-Key: secret")
+}
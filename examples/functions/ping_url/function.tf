@@ -0,0 +1,4 @@
+# "ping://server.example.com"
+output "ping_target" {
+  value = provider::uptime::ping_url("server.example.com")
+}
@@ -0,0 +1,7 @@
+resource "uptime_monitor" "database" {
+  name = "Database"
+  type = "tcp"
+  url  = provider::uptime::tcp_url(aws_db_instance.main.address, aws_db_instance.main.port)
+
+  tcp_settings = {}
+}
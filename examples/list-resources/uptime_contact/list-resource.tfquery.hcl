@@ -0,0 +1,8 @@
+# List every Slack contact
+list "uptime_contact" "slack" {
+  provider = uptime
+
+  config {
+    channel = "slack"
+  }
+}
@@ -0,0 +1,9 @@
+# List every HTTPS monitor whose name contains "checkout"
+list "uptime_monitor" "checkout" {
+  provider = uptime
+
+  config {
+    name = "checkout"
+    type = "https"
+  }
+}
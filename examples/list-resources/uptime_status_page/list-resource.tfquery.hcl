@@ -0,0 +1,4 @@
+# List every status page
+list "uptime_status_page" "all" {
+  provider = uptime
+}
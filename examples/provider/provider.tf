@@ -18,4 +18,34 @@ provider "uptime" {
 provider "uptime" {
   api_key  = "your-api-key"
   base_url = "https://uptime-monitor.io" # Optional, defaults to production API
+}
+
+# Or read credentials from a profile in ~/.config/uptime/credentials
+provider "uptime" {
+  alias   = "staging_account"
+  profile = "staging"
+}
+
+# Share monitor settings across every uptime_monitor
+provider "uptime" {
+  alias = "production"
+
+  monitor_defaults {
+    regions        = ["us-east-1", "eu-west-1", "ap-southeast-1"]
+    contacts       = ["contact-id-1"]
+    check_interval = 30
+    fail_threshold = 2
+  }
+}
+
+# Keep staging objects apart from production ones in a shared account
+provider "uptime" {
+  alias       = "staging"
+  name_prefix = "[staging] "
+}
+
+# Audit and drift-detection runs that must never change the account
+provider "uptime" {
+  alias     = "audit"
+  read_only = true
 }
\ No newline at end of file
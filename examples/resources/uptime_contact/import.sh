@@ -0,0 +1,5 @@
+# Import by ID
+terraform import uptime_contact.example abc123
+
+# Import by name and/or channel
+terraform import uptime_contact.example "channel=slack,name=ops-alerts"
@@ -6,6 +6,9 @@ resource "uptime_contact" "email_contact" {
   email_settings = {
     email = "devops@example.com"
   }
+
+  # Remove the contact from every monitor when it is destroyed
+  force_detach = true
 }
 
 # SMS contact example
@@ -0,0 +1,6 @@
+# Import by ID
+terraform import uptime_monitor.example abc123
+
+# Import by name, URL and/or type
+terraform import uptime_monitor.example "name=Checkout API"
+terraform import uptime_monitor.example "url=https://shop.example.com/health,type=https"
@@ -0,0 +1,5 @@
+# Import by ID
+terraform import uptime_status_page.example abc123
+
+# Import by name and/or custom domain
+terraform import uptime_status_page.example "domain=status.example.com"
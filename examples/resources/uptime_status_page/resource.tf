@@ -10,6 +10,9 @@ resource "uptime_status_page" "public_status" {
   
   # Optional: Time period for uptime statistics (defaults to 7 days)
   period = 30  # Can be 7, 30, or 90 days
+
+  # Optional: Refuse to delete the page, e.g. when a refactor moves it
+  deletion_protection = true
 }
 
 # Status page with custom domain and authentication
@@ -0,0 +1,156 @@
+// Package cli implements the subcommands of the provider binary, which help
+// manage an account outside of a Terraform run. Without a subcommand the
+// binary serves the provider to Terraform.
+package cli
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+
+	"terraform-provider-uptime/internal/client"
+	"terraform-provider-uptime/internal/config"
+)
+
+// Exit codes returned by Run
+const (
+	exitOK      = 0
+	exitFailure = 1
+	exitUsage   = 2
+)
+
+// command is a subcommand of the provider binary
+type command struct {
+	name    string
+	summary string
+	run     func(ctx context.Context, args []string, stdout, stderr io.Writer) int
+}
+
+// commands lists the subcommands in the order they are shown in the help
+var commands = []command{
+	{"export", "Write Terraform configuration and import blocks for the objects in the account", runExport},
+	{"drift", "Compare state files with the account and report orphaned, missing and changed objects", runDrift},
+	{"doctor", "Check credentials, connectivity to the API and the account's plan limits", runDoctor},
+	{"migrate", "Convert an UptimeRobot or Better Stack export into monitor and contact configuration", runMigrate},
+}
+
+// IsCommand reports whether name is a subcommand of the provider binary
+func IsCommand(name string) bool {
+	if name == "help" {
+		return true
+	}
+	_, ok := lookup(name)
+	return ok
+}
+
+// Run runs the subcommand named by args[0] with the remaining arguments and
+// returns the process exit code
+func Run(ctx context.Context, args []string, stdout, stderr io.Writer) int {
+	if len(args) == 0 || args[0] == "help" {
+		printUsage(stdout)
+		return exitOK
+	}
+
+	cmd, ok := lookup(args[0])
+	if !ok {
+		_, _ = fmt.Fprintf(stderr, "unknown command %q\n\n", args[0])
+		printUsage(stderr)
+		return exitUsage
+	}
+
+	return cmd.run(ctx, args[1:], stdout, stderr)
+}
+
+// lookup returns the subcommand called name
+func lookup(name string) (command, bool) {
+	for _, cmd := range commands {
+		if cmd.name == name {
+			return cmd, true
+		}
+	}
+	return command{}, false
+}
+
+// printUsage lists the subcommands
+func printUsage(w io.Writer) {
+	_, _ = fmt.Fprintln(w, "Usage: terraform-provider-uptime <command> [flags]")
+	_, _ = fmt.Fprintln(w)
+	_, _ = fmt.Fprintln(w, "Commands:")
+	for _, cmd := range commands {
+		_, _ = fmt.Fprintf(w, "  %-8s %s\n", cmd.name, cmd.summary)
+	}
+	_, _ = fmt.Fprintln(w)
+	_, _ = fmt.Fprintln(w, "Run \"terraform-provider-uptime <command> -h\" for the flags of a command.")
+	_, _ = fmt.Fprintln(w, "Credentials are read like the provider reads them: from the flags, the")
+	_, _ = fmt.Fprintf(w, "%s and %s environment variables, and the credentials file.\n", config.EnvAPIKey, config.EnvBaseURL)
+}
+
+// credentialFlags holds the flags that select the account, mirroring the
+// provider's api_key_command, base_url and profile arguments
+type credentialFlags struct {
+	apiKeyCommand string
+	baseURL       string
+	profile       string
+}
+
+// addCredentialFlags registers the credential flags on fs
+func addCredentialFlags(fs *flag.FlagSet) *credentialFlags {
+	f := &credentialFlags{}
+	fs.StringVar(&f.apiKeyCommand, "api-key-command", "", "command that prints the API key, like the provider's api_key_command")
+	fs.StringVar(&f.baseURL, "base-url", "", "base URL of the API")
+	fs.StringVar(&f.profile, "profile", "", "profile to read from the credentials file")
+	return f
+}
+
+// resolve determines the credentials the same way the provider does
+func (f *credentialFlags) resolve() (*config.Resolved, error) {
+	return config.Resolve(config.Options{
+		APIKeyCommand: f.apiKeyCommand,
+		BaseURL:       f.baseURL,
+		Profile:       f.profile,
+	})
+}
+
+// newClient resolves the credentials and returns a read-only client for
+// the account they belong to
+func (f *credentialFlags) newClient() (*client.Client, error) {
+	resolved, err := f.resolve()
+	if err != nil {
+		return nil, err
+	}
+	if resolved.APIKey == "" {
+		return nil, fmt.Errorf("no API key found: set %s, pass -api-key-command, or add api_key to profile %q in %s",
+			config.EnvAPIKey, resolved.Profile, resolved.ProfilePath)
+	}
+
+	c := client.NewClient(resolved.BaseURL, resolved.APIKey)
+	if resolved.RefreshAPIKey != nil {
+		c.SetAPIKeyFunc(resolved.RefreshAPIKey, resolved.APIKeyExpiresAt)
+	}
+	c.ReadOnly = true
+
+	return c, nil
+}
+
+// nameFlags holds the flags mirroring the provider's name_prefix and
+// name_suffix, so that names match configuration written for a provider
+// that sets them
+type nameFlags struct {
+	prefix string
+	suffix string
+}
+
+// addNameFlags registers the name flags on fs
+func addNameFlags(fs *flag.FlagSet) *nameFlags {
+	f := &nameFlags{}
+	fs.StringVar(&f.prefix, "name-prefix", "", "name prefix of the environment, like the provider's name_prefix; objects without it are skipped")
+	fs.StringVar(&f.suffix, "name-suffix", "", "name suffix of the environment, like the provider's name_suffix; objects without it are skipped")
+	return f
+}
+
+// apply configures c to add and remove the prefix and suffix
+func (f *nameFlags) apply(c *client.Client) {
+	c.NamePrefix = f.prefix
+	c.NameSuffix = f.suffix
+}
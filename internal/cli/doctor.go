@@ -0,0 +1,340 @@
+package cli
+
+import (
+	"context"
+	"crypto/tls"
+	"flag"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"terraform-provider-uptime/internal/client"
+	"terraform-provider-uptime/internal/config"
+	"terraform-provider-uptime/internal/provider"
+)
+
+// doctorTimeout bounds each network check
+const doctorTimeout = 10 * time.Second
+
+// certificateExpiryWarning is how long before expiry a certificate of the
+// API is reported
+const certificateExpiryWarning = 14 * 24 * time.Hour
+
+// checkStatus is the outcome of a doctor check
+type checkStatus string
+
+const (
+	checkOK   checkStatus = "ok"
+	checkWarn checkStatus = "warn"
+	checkFail checkStatus = "fail"
+	checkSkip checkStatus = "skip"
+)
+
+func runDoctor(ctx context.Context, args []string, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("doctor", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	creds := addCredentialFlags(flags)
+	flags.Usage = func() {
+		_, _ = fmt.Fprintln(stderr, "Usage: terraform-provider-uptime doctor [flags]")
+		_, _ = fmt.Fprintln(stderr)
+		_, _ = fmt.Fprintln(stderr, "Checks the credentials the provider would use, the network path to the API")
+		_, _ = fmt.Fprintln(stderr, "and the account's plan limits.")
+		_, _ = fmt.Fprintln(stderr)
+		flags.PrintDefaults()
+	}
+
+	if err := flags.Parse(args); err != nil {
+		return exitUsage
+	}
+	if flags.NArg() > 0 {
+		flags.Usage()
+		return exitUsage
+	}
+
+	d := &doctor{
+		out:   stdout,
+		creds: creds,
+		proxy: http.ProxyFromEnvironment,
+	}
+	if !d.run(ctx) {
+		_, _ = fmt.Fprintln(stdout)
+		_, _ = fmt.Fprintln(stdout, "Some checks failed. Fix the problems marked [fail] above and run doctor again.")
+		return exitFailure
+	}
+
+	_, _ = fmt.Fprintln(stdout)
+	_, _ = fmt.Fprintln(stdout, "All checks passed.")
+	return exitOK
+}
+
+// doctor runs the diagnostic checks and prints their outcome
+type doctor struct {
+	out   io.Writer
+	creds *credentialFlags
+
+	// proxy selects the proxy for a request, like http.Transport.Proxy
+	proxy func(*http.Request) (*url.URL, error)
+
+	// tlsConfig and httpClient replace the defaults in tests
+	tlsConfig  *tls.Config
+	httpClient *http.Client
+
+	failed bool
+}
+
+// report prints the outcome of a check and, for problems, what to do
+func (d *doctor) report(status checkStatus, name, message, hint string) {
+	_, _ = fmt.Fprintf(d.out, "  [%-4s] %-12s %s\n", status, name, message)
+	if hint != "" && (status == checkFail || status == checkWarn) {
+		for _, line := range strings.Split(hint, "\n") {
+			_, _ = fmt.Fprintf(d.out, "%s%s\n", strings.Repeat(" ", 22), line)
+		}
+	}
+	if status == checkFail {
+		d.failed = true
+	}
+}
+
+// section prints the heading of a group of checks
+func (d *doctor) section(title string) {
+	_, _ = fmt.Fprintf(d.out, "\n%s\n", title)
+}
+
+// run performs the checks in order, stopping at the first group that fails
+// as the later ones depend on it, and reports whether all passed
+func (d *doctor) run(ctx context.Context) bool {
+	_, _ = fmt.Fprint(d.out, "Uptime Monitor provider diagnostics\n")
+
+	d.section("Configuration")
+	resolved := d.checkConfiguration()
+	if d.failed {
+		return false
+	}
+
+	d.section("Network")
+	baseURL := d.checkNetwork(ctx, resolved.BaseURL)
+	if d.failed {
+		return false
+	}
+
+	d.section("Account")
+	d.checkAccount(resolved, baseURL)
+
+	return !d.failed
+}
+
+// checkConfiguration resolves the API key and base URL like the provider's
+// Configure does
+func (d *doctor) checkConfiguration() *config.Resolved {
+	resolved, err := d.creds.resolve()
+	if err != nil {
+		d.report(checkFail, "Credentials", err.Error(),
+			fmt.Sprintf("Fix the credentials file or select an existing profile with -profile or %s.", config.EnvProfile))
+		return nil
+	}
+
+	profile := fmt.Sprintf("%q in %s", resolved.Profile, resolved.ProfilePath)
+	d.report(checkOK, "Profile", profile, "")
+
+	if resolved.APIKey == "" {
+		d.report(checkFail, "API key", "not set",
+			fmt.Sprintf("Set %s, pass -api-key-command, or add api_key = ... to profile %s.", config.EnvAPIKey, profile))
+	} else {
+		d.report(checkOK, "API key", fmt.Sprintf("%s from %s", maskKey(resolved.APIKey), resolved.APIKeySource), "")
+	}
+
+	d.report(checkOK, "Base URL", fmt.Sprintf("%s from %s", resolved.BaseURL, resolved.BaseURLSource), "")
+
+	return resolved
+}
+
+// checkNetwork checks that the base URL is well formed and that its host
+// resolves and accepts connections, directly or through a proxy
+func (d *doctor) checkNetwork(ctx context.Context, rawURL string) *url.URL {
+	baseURL, err := url.Parse(rawURL)
+	if err != nil || (baseURL.Scheme != "https" && baseURL.Scheme != "http") || baseURL.Host == "" {
+		d.report(checkFail, "Base URL", fmt.Sprintf("%q is not an http(s) URL", rawURL),
+			fmt.Sprintf("Set base_url, %s or the profile's base_url to the API's URL, e.g. %s.", config.EnvBaseURL, config.DefaultBaseURL))
+		return nil
+	}
+	if baseURL.Scheme == "http" {
+		d.report(checkWarn, "Base URL", "does not use HTTPS", "The API key is sent unencrypted. Use an https:// base URL outside of local testing.")
+	}
+
+	address := net.JoinHostPort(baseURL.Hostname(), portOf(baseURL))
+
+	proxyURL, err := d.proxy(&http.Request{URL: baseURL})
+	if err != nil {
+		d.report(checkFail, "Proxy", fmt.Sprintf("invalid proxy configuration: %s", err), "Fix the HTTPS_PROXY, HTTP_PROXY and NO_PROXY environment variables.")
+		return nil
+	}
+
+	if proxyURL != nil {
+		d.report(checkOK, "Proxy", fmt.Sprintf("%s (from the environment)", proxyURL.Redacted()), "")
+		proxyAddress := net.JoinHostPort(proxyURL.Hostname(), portOf(proxyURL))
+		if err := d.dial(ctx, proxyAddress); err != nil {
+			d.report(checkFail, "Proxy", fmt.Sprintf("cannot connect to %s: %s", proxyAddress, err),
+				"Check that the proxy is running, or unset HTTPS_PROXY/HTTP_PROXY or add the API host to NO_PROXY.")
+			return nil
+		}
+		// The proxy resolves the API host and connects to it, so TLS is
+		// checked by the API request below
+		d.report(checkSkip, "DNS", "resolved by the proxy", "")
+		return baseURL
+	}
+	d.report(checkOK, "Proxy", "none, connecting directly", "")
+
+	if net.ParseIP(baseURL.Hostname()) != nil {
+		d.report(checkSkip, "DNS", fmt.Sprintf("%s is an IP address", baseURL.Hostname()), "")
+	} else {
+		lookupCtx, cancel := context.WithTimeout(ctx, doctorTimeout)
+		addresses, err := net.DefaultResolver.LookupHost(lookupCtx, baseURL.Hostname())
+		cancel()
+		if err != nil {
+			d.report(checkFail, "DNS", fmt.Sprintf("cannot resolve %s: %s", baseURL.Hostname(), err),
+				"Check the spelling of the base URL and your DNS or VPN settings. If you need a proxy, set HTTPS_PROXY.")
+			return nil
+		}
+		d.report(checkOK, "DNS", fmt.Sprintf("%s resolves to %s", baseURL.Hostname(), strings.Join(addresses, ", ")), "")
+	}
+
+	if baseURL.Scheme == "http" {
+		if err := d.dial(ctx, address); err != nil {
+			d.report(checkFail, "Connection", fmt.Sprintf("cannot connect to %s: %s", address, err),
+				"Check firewalls and that the API is reachable from this machine. If you need a proxy, set HTTP_PROXY.")
+			return nil
+		}
+		d.report(checkOK, "Connection", fmt.Sprintf("connected to %s", address), "")
+		return baseURL
+	}
+
+	d.checkTLS(ctx, baseURL, address)
+	if d.failed {
+		return nil
+	}
+	return baseURL
+}
+
+// checkTLS opens a TLS connection to the API and inspects its certificate
+func (d *doctor) checkTLS(ctx context.Context, baseURL *url.URL, address string) {
+	tlsConfig := &tls.Config{}
+	if d.tlsConfig != nil {
+		tlsConfig = d.tlsConfig.Clone()
+	}
+	tlsConfig.ServerName = baseURL.Hostname()
+
+	dialer := &tls.Dialer{NetDialer: &net.Dialer{Timeout: doctorTimeout}, Config: tlsConfig}
+	conn, err := dialer.DialContext(ctx, "tcp", address)
+	if err != nil {
+		d.report(checkFail, "TLS", fmt.Sprintf("cannot establish a TLS connection to %s: %s", address, err),
+			"A certificate error usually means a proxy or firewall intercepts TLS: add its CA to the system trust store,\n"+
+				"or set HTTPS_PROXY if direct connections are blocked.")
+		return
+	}
+	defer func() { _ = conn.Close() }()
+
+	state := conn.(*tls.Conn).ConnectionState()
+	certificate := state.PeerCertificates[0]
+	message := fmt.Sprintf("%s, certificate for %s valid until %s",
+		tls.VersionName(state.Version), certificate.Subject.CommonName, certificate.NotAfter.Format("2006-01-02"))
+
+	if time.Until(certificate.NotAfter) < certificateExpiryWarning {
+		d.report(checkWarn, "TLS", message, "The API's certificate expires soon.")
+		return
+	}
+	d.report(checkOK, "TLS", message, "")
+}
+
+// checkAccount validates the API key by fetching the account and prints the
+// plan's limits and usage
+func (d *doctor) checkAccount(resolved *config.Resolved, baseURL *url.URL) {
+	c := client.NewClient(resolved.BaseURL, resolved.APIKey)
+	if resolved.RefreshAPIKey != nil {
+		c.SetAPIKeyFunc(resolved.RefreshAPIKey, resolved.APIKeyExpiresAt)
+	}
+	if d.httpClient != nil {
+		c.HTTPClient = d.httpClient
+	}
+	c.ReadOnly = true
+
+	account, err := c.GetAccount()
+	if err != nil {
+		summary, detail := provider.CredentialsErrorDiagnostic(err, baseURL.String())
+		d.report(checkFail, "API key", summary, detail)
+		return
+	}
+	d.report(checkOK, "API key", fmt.Sprintf("valid for %s (account %s)", account.Email, account.ID), "")
+	d.report(checkOK, "Plan", account.CurrentPlan, "")
+
+	d.usage("Monitors", account.MonitorsCount, account.MonitorsLimit, "Delete unused monitors or upgrade the plan before creating more.")
+
+	if contacts, err := c.ListContacts(); err != nil {
+		d.report(checkWarn, "Contacts", fmt.Sprintf("unable to list contacts: %s", err), "")
+	} else {
+		d.usage("Contacts", len(contacts), account.ContactsLimit, "Delete unused contacts or upgrade the plan before creating more.")
+	}
+
+	if statusPages, err := c.ListStatusPages(); err != nil {
+		d.report(checkWarn, "Status pages", fmt.Sprintf("unable to list status pages: %s", err), "")
+	} else {
+		d.usage("Status pages", len(statusPages), account.StatusPagesLimit, "Delete unused status pages or upgrade the plan before creating more.")
+	}
+
+	d.report(checkOK, "Intervals", fmt.Sprintf("checks at most every %ds from up to %d regions", account.MinCheckInterval, account.MaxRegionsPerMonitor), "")
+	if len(account.AllowedRegions) > 0 {
+		d.report(checkOK, "Regions", strings.Join(account.AllowedRegions, ", "), "")
+	}
+	if len(account.EnabledChannels) > 0 {
+		d.report(checkOK, "Channels", strings.Join(account.EnabledChannels, ", "), "")
+	}
+	d.report(checkOK, "SMS credits", fmt.Sprintf("%d remaining", account.SMSCreditsRemaining), "")
+}
+
+// usage reports how much of a plan limit is used, warning when nothing is
+// left. A limit of zero or less means unlimited.
+func (d *doctor) usage(name string, used, limit int, hint string) {
+	if limit <= 0 {
+		d.report(checkOK, name, fmt.Sprintf("%d used, no limit", used), "")
+		return
+	}
+
+	message := fmt.Sprintf("%d of %d used", used, limit)
+	if used >= limit {
+		d.report(checkWarn, name, message+", limit reached", hint)
+		return
+	}
+	d.report(checkOK, name, message, "")
+}
+
+// dial opens and closes a TCP connection to address
+func (d *doctor) dial(ctx context.Context, address string) error {
+	dialer := &net.Dialer{Timeout: doctorTimeout}
+	conn, err := dialer.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return err
+	}
+	return conn.Close()
+}
+
+// portOf returns the port of u, or the default port of its scheme
+func portOf(u *url.URL) string {
+	if port := u.Port(); port != "" {
+		return port
+	}
+	if u.Scheme == "http" {
+		return "80"
+	}
+	return "443"
+}
+
+// maskKey shows only the last characters of an API key
+func maskKey(key string) string {
+	if len(key) <= 8 {
+		return strings.Repeat("*", len(key))
+	}
+	return strings.Repeat("*", 8) + key[len(key)-4:]
+}
@@ -0,0 +1,139 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"terraform-provider-uptime/internal/client"
+)
+
+// noProxy connects directly regardless of the environment
+func noProxy(*http.Request) (*url.URL, error) { return nil, nil }
+
+// doctorServer serves the account endpoints over TLS and returns a doctor
+// that trusts it
+func doctorServer(t *testing.T, validKey string) (*httptest.Server, *doctor, *bytes.Buffer) {
+	t.Helper()
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+validKey {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"status":"error","error":"Invalid API key"}`))
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/account":
+			_ = json.NewEncoder(w).Encode(client.AccountResponse{Status: "ok", Data: &client.Account{
+				ID: "acc_1", Email: "ops@example.com", CurrentPlan: "team",
+				MonitorsCount: 3, MonitorsLimit: 20, ContactsLimit: 10, StatusPagesLimit: 1,
+				MinCheckInterval: 30, MaxRegionsPerMonitor: 3, AllowedRegions: []string{"us-east-1", "eu-west-1"},
+			}})
+		case "/api/contacts":
+			_ = json.NewEncoder(w).Encode(client.ListContactsResponse{Status: "ok", Data: &client.ListContactsData{
+				Contacts: []client.Contact{{ID: "c1"}, {ID: "c2"}},
+			}})
+		case "/api/status_pages":
+			_ = json.NewEncoder(w).Encode(client.ListStatusPagesResponse{Status: "ok", Data: &client.ListStatusPagesData{
+				StatusPages: []client.StatusPage{{ID: "s1"}},
+			}})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	roots := x509.NewCertPool()
+	roots.AddCert(server.Certificate())
+
+	t.Setenv("UPTIME_CONFIG_FILE", filepath.Join(t.TempDir(), "credentials"))
+	t.Setenv("UPTIME_API_KEY", validKey)
+
+	var out bytes.Buffer
+	d := &doctor{
+		out:        &out,
+		creds:      &credentialFlags{baseURL: server.URL},
+		proxy:      noProxy,
+		tlsConfig:  &tls.Config{RootCAs: roots},
+		httpClient: server.Client(),
+	}
+	return server, d, &out
+}
+
+func TestDoctor_AllChecksPass(t *testing.T) {
+	_, d, out := doctorServer(t, "key-0123456789")
+
+	require.True(t, d.run(context.Background()), out.String())
+
+	assert.Contains(t, out.String(), "[ok  ] API key      ********6789 from UPTIME_API_KEY")
+	assert.Contains(t, out.String(), "[skip] DNS          127.0.0.1 is an IP address")
+	assert.Contains(t, out.String(), "[ok  ] TLS          TLS 1.3")
+	assert.Contains(t, out.String(), "[ok  ] API key      valid for ops@example.com (account acc_1)")
+	assert.Contains(t, out.String(), "[ok  ] Monitors     3 of 20 used")
+	assert.Contains(t, out.String(), "[ok  ] Contacts     2 of 10 used")
+	assert.Contains(t, out.String(), "[warn] Status pages 1 of 1 used, limit reached")
+	assert.Contains(t, out.String(), "Delete unused status pages or upgrade the plan")
+}
+
+func TestDoctor_InvalidKey(t *testing.T) {
+	_, d, out := doctorServer(t, "key-0123456789")
+	t.Setenv("UPTIME_API_KEY", "revoked-key")
+
+	assert.False(t, d.run(context.Background()))
+	assert.Contains(t, out.String(), "[fail] API key      Invalid API Key")
+	assert.Contains(t, out.String(), "rejected the API key (HTTP 401)")
+}
+
+func TestDoctor_MissingKey(t *testing.T) {
+	_, d, out := doctorServer(t, "key-0123456789")
+	t.Setenv("UPTIME_API_KEY", "")
+
+	assert.False(t, d.run(context.Background()))
+	assert.Contains(t, out.String(), "[fail] API key      not set")
+	assert.NotContains(t, out.String(), "Network")
+}
+
+func TestDoctor_UntrustedCertificate(t *testing.T) {
+	_, d, out := doctorServer(t, "key-0123456789")
+	d.tlsConfig = nil
+
+	assert.False(t, d.run(context.Background()))
+	assert.Contains(t, out.String(), "[fail] TLS          cannot establish a TLS connection")
+	assert.Contains(t, out.String(), "add its CA to the system trust store")
+	assert.NotContains(t, out.String(), "Account")
+}
+
+func TestDoctor_UnreachableProxy(t *testing.T) {
+	_, d, out := doctorServer(t, "key-0123456789")
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	proxyURL := &url.URL{Scheme: "http", User: url.UserPassword("user", "secret"), Host: listener.Addr().String()}
+	require.NoError(t, listener.Close())
+	d.proxy = http.ProxyURL(proxyURL)
+
+	assert.False(t, d.run(context.Background()))
+	assert.Contains(t, out.String(), "[ok  ] Proxy        http://user:xxxxx@"+proxyURL.Host)
+	assert.Contains(t, out.String(), "[fail] Proxy        cannot connect to "+proxyURL.Host)
+	assert.NotContains(t, out.String(), "secret")
+}
+
+func TestDoctor_InvalidBaseURL(t *testing.T) {
+	_, d, out := doctorServer(t, "key-0123456789")
+	d.creds.baseURL = "api.example.com"
+
+	assert.False(t, d.run(context.Background()))
+	assert.Contains(t, out.String(), `[fail] Base URL     "api.example.com" is not an http(s) URL`)
+}
@@ -0,0 +1,551 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"terraform-provider-uptime/internal/client"
+	"terraform-provider-uptime/internal/resources"
+)
+
+// exitDrift is returned by drift -fail-on-drift when differences are found
+const exitDrift = 3
+
+// providerOnlyAttributes are kept by the provider rather than the API, so
+// the account has no value to compare them with
+var providerOnlyAttributes = map[string]bool{
+	"deletion_protection": true,
+	"force_detach":        true,
+}
+
+// stringsFlag is a flag that may be given several times
+type stringsFlag []string
+
+func (f *stringsFlag) String() string { return strings.Join(*f, ", ") }
+
+func (f *stringsFlag) Set(value string) error {
+	*f = append(*f, value)
+	return nil
+}
+
+func runDrift(ctx context.Context, args []string, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("drift", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	var statePaths stringsFlag
+	flags.Var(&statePaths, "state", "state file to compare, as written by terraform state pull (required, may be repeated)")
+	format := flags.String("format", "table", "output format: table or json")
+	failOnDrift := flags.Bool("fail-on-drift", false, fmt.Sprintf("exit with status %d when any drift is found", exitDrift))
+	creds := addCredentialFlags(flags)
+	names := addNameFlags(flags)
+	flags.Usage = func() {
+		_, _ = fmt.Fprintln(stderr, "Usage: terraform-provider-uptime drift -state <file> [-state <file>...] [flags]")
+		_, _ = fmt.Fprintln(stderr)
+		_, _ = fmt.Fprintln(stderr, "Compares state files with the account and reports objects no state manages,")
+		_, _ = fmt.Fprintln(stderr, "objects in state that no longer exist, and attributes that differ.")
+		_, _ = fmt.Fprintln(stderr)
+		flags.PrintDefaults()
+	}
+
+	if err := flags.Parse(args); err != nil {
+		return exitUsage
+	}
+	if len(statePaths) == 0 || flags.NArg() > 0 || (*format != "table" && *format != "json") {
+		flags.Usage()
+		return exitUsage
+	}
+
+	var managed []stateObject
+	for _, path := range statePaths {
+		objects, err := readStateFile(path)
+		if err != nil {
+			_, _ = fmt.Fprintf(stderr, "Error: %s\n", err)
+			return exitFailure
+		}
+		managed = append(managed, objects...)
+	}
+
+	c, err := creds.newClient()
+	if err != nil {
+		_, _ = fmt.Fprintf(stderr, "Error: %s\n", err)
+		return exitFailure
+	}
+	names.apply(c)
+
+	live, err := listLiveObjects(ctx, c)
+	if err != nil {
+		_, _ = fmt.Fprintf(stderr, "Error: %s\n", err)
+		return exitFailure
+	}
+
+	report := compareDrift(managed, live)
+
+	if *format == "json" {
+		encoder := json.NewEncoder(stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(report); err != nil {
+			_, _ = fmt.Fprintf(stderr, "Error: %s\n", err)
+			return exitFailure
+		}
+	} else {
+		report.writeTable(stdout)
+	}
+
+	if *failOnDrift && report.hasDrift() {
+		return exitDrift
+	}
+	return exitOK
+}
+
+// stateObject is an instance of an uptime resource in a state file
+type stateObject struct {
+	Type       string
+	Address    string
+	StateFile  string
+	ID         string
+	Attributes map[string]any
+}
+
+// stateFile is the part of the Terraform state format read by drift
+type stateFile struct {
+	Version   int `json:"version"`
+	Resources []struct {
+		Module    string `json:"module"`
+		Mode      string `json:"mode"`
+		Type      string `json:"type"`
+		Name      string `json:"name"`
+		Instances []struct {
+			IndexKey   any            `json:"index_key"`
+			Attributes map[string]any `json:"attributes"`
+		} `json:"instances"`
+	} `json:"resources"`
+}
+
+// readStateFile returns the uptime resources managed by a state file
+func readStateFile(path string) ([]stateObject, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var state stateFile
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&state); err != nil {
+		return nil, fmt.Errorf("unable to parse state file %s: %w", path, err)
+	}
+	if state.Version != 4 {
+		return nil, fmt.Errorf("state file %s has version %d, only version 4 is supported", path, state.Version)
+	}
+
+	var objects []stateObject
+	for _, r := range state.Resources {
+		if r.Mode != "managed" || resourceTypes[r.Type] == nil {
+			continue
+		}
+
+		address := r.Type + "." + r.Name
+		if r.Module != "" {
+			address = r.Module + "." + address
+		}
+
+		for _, instance := range r.Instances {
+			id, _ := instance.Attributes["id"].(string)
+			objects = append(objects, stateObject{
+				Type:       r.Type,
+				Address:    address + indexSuffix(instance.IndexKey),
+				StateFile:  path,
+				ID:         id,
+				Attributes: instance.Attributes,
+			})
+		}
+	}
+
+	return objects, nil
+}
+
+// indexSuffix returns the address suffix of a count or for_each instance
+func indexSuffix(key any) string {
+	switch key := key.(type) {
+	case nil:
+		return ""
+	case string:
+		return fmt.Sprintf("[%q]", key)
+	default:
+		return fmt.Sprintf("[%v]", key)
+	}
+}
+
+// liveObject is a monitor, contact or status page in the account
+type liveObject struct {
+	Type       string
+	ID         string
+	Name       string
+	Attributes map[string]any
+}
+
+// resourceTypes holds the resource types compared by drift
+var resourceTypes = map[string]func() resource.Resource{
+	"uptime_monitor":     resources.NewMonitorResource,
+	"uptime_contact":     resources.NewContactResource,
+	"uptime_status_page": resources.NewStatusPageResource,
+}
+
+// resourceAttributes returns the schema attributes of an uptime resource
+// type, or nil for other types
+func resourceAttributes(resourceType string) map[string]schema.Attribute {
+	newResource, ok := resourceTypes[resourceType]
+	if !ok {
+		return nil
+	}
+
+	var resp resource.SchemaResponse
+	newResource().Schema(context.Background(), resource.SchemaRequest{}, &resp)
+	return resp.Schema.Attributes
+}
+
+// listLiveObjects lists the monitors, contacts and status pages of the
+// account with their attributes as Terraform would store them in state
+func listLiveObjects(ctx context.Context, c *client.Client) ([]liveObject, error) {
+	var objects []liveObject
+	add := func(resourceType, id, name string, state tfsdk.State) error {
+		attributes, err := stateAttributes(state.Raw)
+		if err != nil {
+			return fmt.Errorf("unable to convert %s %s: %w", resourceType, id, err)
+		}
+		objects = append(objects, liveObject{Type: resourceType, ID: id, Name: name, Attributes: attributes.(map[string]any)})
+		return nil
+	}
+
+	monitors, err := c.ListMonitors()
+	if err != nil {
+		return nil, fmt.Errorf("unable to list monitors: %w", err)
+	}
+	for _, monitor := range monitors {
+		state, diags := resources.MonitorState(ctx, &monitor)
+		if err := diagnosticsError(diags); err != nil {
+			return nil, err
+		}
+		if err := add("uptime_monitor", monitor.ID, monitor.Name, state); err != nil {
+			return nil, err
+		}
+	}
+
+	contacts, err := c.ListContacts()
+	if err != nil {
+		return nil, fmt.Errorf("unable to list contacts: %w", err)
+	}
+	for _, contact := range contacts {
+		state, diags := resources.ContactState(ctx, &contact)
+		if err := diagnosticsError(diags); err != nil {
+			return nil, err
+		}
+		if err := add("uptime_contact", contact.ID, contact.Name, state); err != nil {
+			return nil, err
+		}
+	}
+
+	statusPages, err := c.ListStatusPages()
+	if err != nil {
+		return nil, fmt.Errorf("unable to list status pages: %w", err)
+	}
+	for _, statusPage := range statusPages {
+		state, diags := resources.StatusPageState(ctx, &statusPage)
+		if err := diagnosticsError(diags); err != nil {
+			return nil, err
+		}
+		if err := add("uptime_status_page", statusPage.ID, statusPage.Name, state); err != nil {
+			return nil, err
+		}
+	}
+
+	return objects, nil
+}
+
+// stateAttributes converts a value into the form encoding/json decodes the
+// same value in a state file into, with numbers as json.Number
+func stateAttributes(v tftypes.Value) (any, error) {
+	if v.IsNull() {
+		return nil, nil
+	}
+
+	switch {
+	case v.Type().Is(tftypes.List{}), v.Type().Is(tftypes.Set{}), v.Type().Is(tftypes.Tuple{}):
+		var elements []tftypes.Value
+		if err := v.As(&elements); err != nil {
+			return nil, err
+		}
+		values := make([]any, 0, len(elements))
+		for _, element := range elements {
+			value, err := stateAttributes(element)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, value)
+		}
+		return values, nil
+
+	case v.Type().Is(tftypes.Map{}), v.Type().Is(tftypes.Object{}):
+		var elements map[string]tftypes.Value
+		if err := v.As(&elements); err != nil {
+			return nil, err
+		}
+		values := make(map[string]any, len(elements))
+		for name, element := range elements {
+			value, err := stateAttributes(element)
+			if err != nil {
+				return nil, err
+			}
+			values[name] = value
+		}
+		return values, nil
+	}
+
+	switch {
+	case v.Type().Is(tftypes.Number):
+		n := new(big.Float)
+		err := v.As(&n)
+		return json.Number(n.Text('f', -1)), err
+	case v.Type().Is(tftypes.Bool):
+		var b bool
+		err := v.As(&b)
+		return b, err
+	}
+
+	var s string
+	err := v.As(&s)
+	return s, err
+}
+
+// driftReport lists the differences between state files and the account
+type driftReport struct {
+	// Orphans are objects in the account that no state file manages
+	Orphans []driftObject `json:"orphans"`
+
+	// Missing are objects in a state file that the account no longer has
+	Missing []driftObject `json:"missing"`
+
+	// Changed are objects whose attributes differ from their state
+	Changed []driftChange `json:"changed"`
+}
+
+// driftObject identifies an orphaned or missing object
+type driftObject struct {
+	Type      string `json:"type"`
+	ID        string `json:"id"`
+	Name      string `json:"name,omitempty"`
+	Address   string `json:"address,omitempty"`
+	StateFile string `json:"state_file,omitempty"`
+}
+
+// driftChange lists the attributes of an object that differ
+type driftChange struct {
+	Type        string            `json:"type"`
+	ID          string            `json:"id"`
+	Name        string            `json:"name"`
+	Address     string            `json:"address"`
+	StateFile   string            `json:"state_file"`
+	Differences []driftDifference `json:"differences"`
+}
+
+// driftDifference is an attribute whose state and live values differ
+type driftDifference struct {
+	Attribute string `json:"attribute"`
+	State     any    `json:"state"`
+	Live      any    `json:"live"`
+}
+
+// sensitiveValue replaces the values of sensitive attributes in reports
+const sensitiveValue = "(sensitive)"
+
+// compareDrift compares the objects managed by state files with the
+// objects in the account
+func compareDrift(managed []stateObject, live []liveObject) driftReport {
+	report := driftReport{
+		Orphans: []driftObject{},
+		Missing: []driftObject{},
+		Changed: []driftChange{},
+	}
+
+	liveByKey := make(map[string]liveObject, len(live))
+	for _, object := range live {
+		liveByKey[object.Type+"/"+object.ID] = object
+	}
+
+	managedKeys := make(map[string]bool, len(managed))
+	for _, object := range managed {
+		key := object.Type + "/" + object.ID
+		managedKeys[key] = true
+
+		liveObject, ok := liveByKey[key]
+		if !ok {
+			name, _ := object.Attributes["name"].(string)
+			report.Missing = append(report.Missing, driftObject{
+				Type: object.Type, ID: object.ID, Name: name, Address: object.Address, StateFile: object.StateFile,
+			})
+			continue
+		}
+
+		var differences []driftDifference
+		diffAttributes("", resourceAttributes(object.Type), object.Attributes, liveObject.Attributes, &differences)
+		if len(differences) > 0 {
+			report.Changed = append(report.Changed, driftChange{
+				Type: object.Type, ID: object.ID, Name: liveObject.Name, Address: object.Address, StateFile: object.StateFile,
+				Differences: differences,
+			})
+		}
+	}
+
+	for _, object := range live {
+		if !managedKeys[object.Type+"/"+object.ID] {
+			report.Orphans = append(report.Orphans, driftObject{Type: object.Type, ID: object.ID, Name: object.Name})
+		}
+	}
+
+	return report
+}
+
+// diffAttributes appends the configurable attributes whose state and live
+// values differ. Nested objects are compared attribute by attribute.
+func diffAttributes(prefix string, attributes map[string]schema.Attribute, state, live map[string]any, differences *[]driftDifference) {
+	names := make([]string, 0, len(attributes))
+	for name := range attributes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		attribute := attributes[name]
+		if (prefix == "" && providerOnlyAttributes[name]) || (!attribute.IsRequired() && !attribute.IsOptional()) {
+			continue
+		}
+
+		stateValue, liveValue := state[name], live[name]
+		if nested, ok := attribute.(schema.SingleNestedAttribute); ok {
+			stateObject, stateOK := stateValue.(map[string]any)
+			liveObject, liveOK := liveValue.(map[string]any)
+			if stateOK && liveOK {
+				diffAttributes(prefix+name+".", nested.Attributes, stateObject, liveObject, differences)
+				continue
+			}
+		}
+
+		if reflect.DeepEqual(stateValue, liveValue) {
+			continue
+		}
+		stateValue, liveValue = redact(attribute, stateValue), redact(attribute, liveValue)
+		*differences = append(*differences, driftDifference{Attribute: prefix + name, State: stateValue, Live: liveValue})
+	}
+}
+
+// redact hides a sensitive value, keeping whether it is set. Nested objects,
+// e.g. a contact's settings set on one side only, are reported whole, so
+// their sensitive attributes are hidden too.
+func redact(attribute schema.Attribute, value any) any {
+	if value == nil {
+		return nil
+	}
+	if attribute.IsSensitive() {
+		return sensitiveValue
+	}
+
+	switch nested := attribute.(type) {
+	case schema.SingleNestedAttribute:
+		if object, ok := value.(map[string]any); ok {
+			return redactObject(nested.Attributes, object)
+		}
+	case schema.ListNestedAttribute:
+		if elements, ok := value.([]any); ok {
+			redacted := make([]any, len(elements))
+			for i, element := range elements {
+				redacted[i] = element
+				if object, ok := element.(map[string]any); ok {
+					redacted[i] = redactObject(nested.NestedObject.Attributes, object)
+				}
+			}
+			return redacted
+		}
+	}
+	return value
+}
+
+// redactObject returns a copy of a nested object with its sensitive
+// attributes hidden
+func redactObject(attributes map[string]schema.Attribute, object map[string]any) map[string]any {
+	redacted := make(map[string]any, len(object))
+	for name, value := range object {
+		if attribute, ok := attributes[name]; ok {
+			value = redact(attribute, value)
+		}
+		redacted[name] = value
+	}
+	return redacted
+}
+
+// hasDrift reports whether the report lists any difference
+func (r driftReport) hasDrift() bool {
+	return len(r.Orphans) > 0 || len(r.Missing) > 0 || len(r.Changed) > 0
+}
+
+// writeTable writes the report as aligned text tables
+func (r driftReport) writeTable(w io.Writer) {
+	if !r.hasDrift() {
+		_, _ = fmt.Fprintln(w, "No drift: every object in the account is managed and matches its state.")
+		return
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+	_, _ = fmt.Fprintf(tw, "Orphaned objects, not managed by any state (%d)\n", len(r.Orphans))
+	if len(r.Orphans) > 0 {
+		_, _ = fmt.Fprintln(tw, "TYPE\tID\tNAME")
+		for _, object := range r.Orphans {
+			_, _ = fmt.Fprintf(tw, "%s\t%s\t%s\n", object.Type, object.ID, object.Name)
+		}
+	}
+
+	_, _ = fmt.Fprintf(tw, "\nMissing objects, in state but not in the account (%d)\n", len(r.Missing))
+	if len(r.Missing) > 0 {
+		_, _ = fmt.Fprintln(tw, "ADDRESS\tID\tSTATE FILE")
+		for _, object := range r.Missing {
+			_, _ = fmt.Fprintf(tw, "%s\t%s\t%s\n", object.Address, object.ID, object.StateFile)
+		}
+	}
+
+	_, _ = fmt.Fprintf(tw, "\nChanged objects (%d)\n", len(r.Changed))
+	if len(r.Changed) > 0 {
+		_, _ = fmt.Fprintln(tw, "ADDRESS\tATTRIBUTE\tSTATE\tLIVE")
+		for _, change := range r.Changed {
+			for _, difference := range change.Differences {
+				_, _ = fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", change.Address, difference.Attribute, formatValue(difference.State), formatValue(difference.Live))
+			}
+		}
+	}
+
+	_ = tw.Flush()
+}
+
+// formatValue renders a state value as compact JSON for the table
+func formatValue(value any) string {
+	if s, ok := value.(string); ok && s == sensitiveValue {
+		return s
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(data)
+}
@@ -0,0 +1,216 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// appState manages a contact, a monitor that has drifted and a monitor that
+// has been deleted from the account
+const appState = `{
+  "version": 4,
+  "terraform_version": "1.9.0",
+  "resources": [
+    {
+      "mode": "managed",
+      "type": "uptime_contact",
+      "name": "ops",
+      "instances": [
+        {"attributes": {"id": "c1", "name": "Ops Email", "channel": "email", "active": true, "down_alerts_only": false,
+          "deletion_protection": true, "force_detach": false, "email_settings": {"email": "ops@example.com"}}}
+      ]
+    },
+    {
+      "mode": "managed",
+      "type": "uptime_monitor",
+      "name": "api",
+      "instances": [
+        {"index_key": 0, "attributes": {"id": "m1", "name": "Checkout API", "url": "https://shop.example.com/health", "type": "https",
+          "active": true, "check_interval": 30, "timeout": 30, "fail_threshold": 2, "regions": ["us-east-1", "eu-west-1"],
+          "contacts": ["c1", "c2"], "host": "shop.example.com", "port": 443,
+          "https_settings": {"method": "GET", "expected_status_codes": null, "check_certificate_expiration": true,
+            "follow_redirects": true, "request_headers": {"Accept": "application/json", "Authorization": "Bearer t0ken", "X-Api-Version": "2"},
+            "request_body": null, "expected_response_body": null, "expected_response_headers": null}}}
+      ]
+    },
+    {
+      "module": "module.db",
+      "mode": "managed",
+      "type": "uptime_monitor",
+      "name": "gone",
+      "instances": [
+        {"attributes": {"id": "m9", "name": "Old Database"}}
+      ]
+    },
+    {
+      "mode": "data",
+      "type": "uptime_monitor",
+      "name": "lookup",
+      "instances": [
+        {"attributes": {"id": "m2", "name": "Checkout DB"}}
+      ]
+    }
+  ]
+}`
+
+// statusState manages the status page with outdated credentials
+const statusState = `{
+  "version": 4,
+  "resources": [
+    {
+      "mode": "managed",
+      "type": "uptime_status_page",
+      "name": "shop",
+      "instances": [
+        {"attributes": {"id": "s1", "name": "Shop Status", "monitors": ["m1", "m2"], "period": 30,
+          "show_incident_reasons": false, "basic_auth": "viewer:old"}}
+      ]
+    }
+  ]
+}`
+
+// writeState writes a state file to a temporary directory
+func writeState(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "terraform.tfstate")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func runDriftCommand(t *testing.T, args ...string) (int, string, string) {
+	t.Helper()
+	server := accountServer(t)
+	t.Setenv("UPTIME_API_KEY", "key")
+	t.Setenv("UPTIME_CONFIG_FILE", filepath.Join(t.TempDir(), "credentials"))
+
+	var stdout, stderr bytes.Buffer
+	code := Run(context.Background(), append([]string{"drift", "-base-url", server.URL}, args...), &stdout, &stderr)
+	return code, stdout.String(), stderr.String()
+}
+
+func TestDrift_JSON(t *testing.T) {
+	appPath := writeState(t, appState)
+	statusPath := writeState(t, statusState)
+
+	code, stdout, stderr := runDriftCommand(t, "-state", appPath, "-state", statusPath, "-format", "json")
+	require.Equal(t, exitOK, code, stderr)
+
+	var report driftReport
+	require.NoError(t, json.Unmarshal([]byte(stdout), &report))
+
+	assert.Equal(t, []driftObject{
+		{Type: "uptime_monitor", ID: "m2", Name: "Checkout DB"},
+		{Type: "uptime_contact", ID: "c2", Name: "Ops PagerDuty"},
+		{Type: "uptime_contact", ID: "c3", Name: "Ops Slack"},
+	}, report.Orphans)
+
+	assert.Equal(t, []driftObject{
+		{Type: "uptime_monitor", ID: "m9", Name: "Old Database", Address: "module.db.uptime_monitor.gone", StateFile: appPath},
+	}, report.Missing)
+
+	require.Len(t, report.Changed, 2)
+	assert.Equal(t, "uptime_monitor.api[0]", report.Changed[0].Address)
+	assert.Equal(t, []driftDifference{
+		{Attribute: "check_interval", State: float64(30), Live: float64(60)},
+		{Attribute: "https_settings.method", State: "GET", Live: "HEAD"},
+	}, report.Changed[0].Differences)
+
+	assert.Equal(t, "uptime_status_page.shop", report.Changed[1].Address)
+	assert.Equal(t, statusPath, report.Changed[1].StateFile)
+	assert.Equal(t, []driftDifference{
+		{Attribute: "basic_auth", State: sensitiveValue, Live: sensitiveValue},
+	}, report.Changed[1].Differences)
+	assert.NotContains(t, stdout, "s3cret")
+}
+
+func TestDrift_NamePrefix(t *testing.T) {
+	server := prefixedAccountServer(t, "[staging] ")
+	t.Setenv("UPTIME_API_KEY", "key")
+	t.Setenv("UPTIME_CONFIG_FILE", filepath.Join(t.TempDir(), "credentials"))
+	appPath := writeState(t, appState)
+	statusPath := writeState(t, statusState)
+
+	var stdout, stderr bytes.Buffer
+	code := Run(context.Background(), []string{
+		"drift", "-base-url", server.URL, "-name-prefix", "[staging] ",
+		"-state", appPath, "-state", statusPath, "-format", "json",
+	}, &stdout, &stderr)
+	require.Equal(t, exitOK, code, stderr.String())
+
+	var report driftReport
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &report))
+
+	// State holds the names without the prefix, and the monitor of the
+	// other environment is neither an orphan nor a match
+	assert.Equal(t, []driftObject{
+		{Type: "uptime_monitor", ID: "m2", Name: "Checkout DB"},
+		{Type: "uptime_contact", ID: "c2", Name: "Ops PagerDuty"},
+		{Type: "uptime_contact", ID: "c3", Name: "Ops Slack"},
+	}, report.Orphans)
+	for _, changed := range report.Changed {
+		for _, difference := range changed.Differences {
+			assert.NotEqual(t, "name", difference.Attribute, changed.Address)
+		}
+	}
+}
+
+func TestDrift_Table(t *testing.T) {
+	code, stdout, stderr := runDriftCommand(t, "-state", writeState(t, appState), "-fail-on-drift")
+	require.Equal(t, exitDrift, code, stderr)
+
+	assert.Contains(t, stdout, "Orphaned objects, not managed by any state (4)")
+	assert.Contains(t, stdout, "uptime_status_page  s1  Shop Status")
+	assert.Contains(t, stdout, "Missing objects, in state but not in the account (1)")
+	assert.Contains(t, stdout, "module.db.uptime_monitor.gone  m9")
+	assert.Contains(t, stdout, "uptime_monitor.api[0]  check_interval         30     60")
+	assert.Contains(t, stdout, `uptime_monitor.api[0]  https_settings.method  "GET"  "HEAD"`)
+}
+
+func TestDrift_InvalidState(t *testing.T) {
+	code, _, stderr := runDriftCommand(t, "-state", writeState(t, `{"version": 3, "resources": []}`))
+	assert.Equal(t, exitFailure, code)
+	assert.Contains(t, stderr, "only version 4 is supported")
+
+	code, _, _ = runDriftCommand(t)
+	assert.Equal(t, exitUsage, code)
+}
+
+func TestDrift_RedactsNestedObjects(t *testing.T) {
+	// The state has the PagerDuty contact's settings null, so the live
+	// settings differ as a whole
+	statePath := writeState(t, `{
+  "version": 4,
+  "resources": [
+    {
+      "mode": "managed",
+      "type": "uptime_contact",
+      "name": "pagerduty",
+      "instances": [
+        {"attributes": {"id": "c2", "name": "Ops PagerDuty", "channel": "pagerduty", "active": true, "down_alerts_only": true,
+          "pagerduty_settings": null}}
+      ]
+    }
+  ]
+}`)
+
+	code, stdout, stderr := runDriftCommand(t, "-state", statePath, "-format", "json")
+	require.Equal(t, exitOK, code, stderr)
+	assert.NotContains(t, stdout, "abc123")
+
+	var report driftReport
+	require.NoError(t, json.Unmarshal([]byte(stdout), &report))
+	require.Len(t, report.Changed, 1)
+	assert.Equal(t, []driftDifference{
+		{
+			Attribute: "pagerduty_settings",
+			Live:      map[string]any{"integration_key": sensitiveValue, "auto_resolve_incidents": false, "severity_mapping": nil},
+		},
+	}, report.Changed[0].Differences)
+}
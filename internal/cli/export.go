@@ -0,0 +1,603 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/fs"
+	"math/big"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"terraform-provider-uptime/internal/client"
+	"terraform-provider-uptime/internal/resources"
+)
+
+// providerSource is the registry address of the provider
+const providerSource = "uptime-monitor-io/uptime"
+
+// attributeOrder lists the attributes written first, in this order. Other
+// attributes follow in alphabetical order.
+var attributeOrder = []string{"name", "url", "type", "channel", "check_interval", "timeout", "fail_threshold", "regions", "contacts", "monitors"}
+
+func runExport(ctx context.Context, args []string, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("export", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	out := flags.String("out", "", "directory to write the configuration to (required)")
+	force := flags.Bool("force", false, "overwrite files that already exist in the output directory")
+	creds := addCredentialFlags(flags)
+	names := addNameFlags(flags)
+	flags.Usage = func() {
+		_, _ = fmt.Fprintln(stderr, "Usage: terraform-provider-uptime export -out <dir> [flags]")
+		_, _ = fmt.Fprintln(stderr)
+		_, _ = fmt.Fprintln(stderr, "Writes an uptime_monitor, uptime_contact or uptime_status_page resource and an")
+		_, _ = fmt.Fprintln(stderr, "import block for every object in the account. Secrets become variables.")
+		_, _ = fmt.Fprintln(stderr)
+		flags.PrintDefaults()
+	}
+
+	if err := flags.Parse(args); err != nil {
+		return exitUsage
+	}
+	if *out == "" || flags.NArg() > 0 {
+		flags.Usage()
+		return exitUsage
+	}
+
+	c, err := creds.newClient()
+	if err != nil {
+		_, _ = fmt.Fprintf(stderr, "Error: %s\n", err)
+		return exitFailure
+	}
+	names.apply(c)
+
+	export, err := exportAccount(ctx, c)
+	if err != nil {
+		_, _ = fmt.Fprintf(stderr, "Error: %s\n", err)
+		return exitFailure
+	}
+
+	if err := writeFiles(*out, export.files(), *force); err != nil {
+		_, _ = fmt.Fprintf(stderr, "Error: %s\n", err)
+		return exitFailure
+	}
+
+	_, _ = fmt.Fprintf(stdout, "Exported %s, %s and %s to %s\n",
+		count(len(export.monitors), "monitor"), count(len(export.contacts), "contact"), count(len(export.statusPages), "status page"), *out)
+	if len(export.variables) > 0 {
+		_, _ = fmt.Fprintf(stdout, "Set the %d secret variables declared in variables.tf before running terraform plan\n", len(export.variables))
+	}
+
+	return exitOK
+}
+
+// export holds the configuration generated for an account
+type export struct {
+	contacts    []*hclBlock
+	monitors    []*hclBlock
+	statusPages []*hclBlock
+	imports     []*hclBlock
+	variables   []*hclBlock
+
+	names blockNames
+
+	// references maps object IDs to the reference expression of their
+	// resource's id attribute, by resource type
+	references map[string]map[string]string
+}
+
+// exportAccount lists the contacts, monitors and status pages of the account
+// and generates their configuration
+func exportAccount(ctx context.Context, c *client.Client) (*export, error) {
+	e := &export{
+		names:      blockNames{},
+		references: make(map[string]map[string]string),
+	}
+
+	contacts, err := c.ListContacts()
+	if err != nil {
+		return nil, fmt.Errorf("unable to list contacts: %w", err)
+	}
+	for _, contact := range contacts {
+		state, diags := resources.ContactState(ctx, &contact)
+		if err := diagnosticsError(diags); err != nil {
+			return nil, err
+		}
+		block, err := e.resource(ctx, "uptime_contact", contact.ID, contact.Name, state)
+		if err != nil {
+			return nil, err
+		}
+		e.contacts = append(e.contacts, block)
+	}
+
+	monitors, err := c.ListMonitors()
+	if err != nil {
+		return nil, fmt.Errorf("unable to list monitors: %w", err)
+	}
+	for _, monitor := range monitors {
+		omitDerivedHost(&monitor)
+		state, diags := resources.MonitorState(ctx, &monitor)
+		if err := diagnosticsError(diags); err != nil {
+			return nil, err
+		}
+		block, err := e.resource(ctx, "uptime_monitor", monitor.ID, monitor.Name, state)
+		if err != nil {
+			return nil, err
+		}
+		e.monitors = append(e.monitors, block)
+	}
+
+	statusPages, err := c.ListStatusPages()
+	if err != nil {
+		return nil, fmt.Errorf("unable to list status pages: %w", err)
+	}
+	for _, statusPage := range statusPages {
+		state, diags := resources.StatusPageState(ctx, &statusPage)
+		if err := diagnosticsError(diags); err != nil {
+			return nil, err
+		}
+		block, err := e.resource(ctx, "uptime_status_page", statusPage.ID, statusPage.Name, state)
+		if err != nil {
+			return nil, err
+		}
+		e.statusPages = append(e.statusPages, block)
+	}
+
+	return e, nil
+}
+
+// files returns the generated files by name
+func (e *export) files() map[string][]byte {
+	files := map[string][]byte{
+		"terraform.tf": hclFile([]*hclBlock{terraformBlock()}),
+	}
+	for name, blocks := range map[string][]*hclBlock{
+		"contacts.tf":     e.contacts,
+		"monitors.tf":     e.monitors,
+		"status_pages.tf": e.statusPages,
+		"imports.tf":      e.imports,
+		"variables.tf":    e.variables,
+	} {
+		if len(blocks) > 0 {
+			files[name] = hclFile(blocks)
+		}
+	}
+	return files
+}
+
+// terraformBlock returns the terraform block that requires the provider
+func terraformBlock() *hclBlock {
+	uptime := &hclBody{}
+	uptime.set("source", hclRaw(hclString(providerSource)))
+	providers := &hclBody{}
+	providers.set("uptime", uptime)
+
+	block := newBlock("terraform")
+	block.body.set("required_providers", providers)
+	return block
+}
+
+// sensitiveVariable returns the declaration of a sensitive string variable
+// that stands in for a secret
+func sensitiveVariable(name, description string) *hclBlock {
+	block := newBlock("variable", name)
+	block.body.set("description", hclRaw(hclString(description)))
+	block.body.set("type", hclRaw("string"))
+	block.body.set("sensitive", hclRaw("true"))
+	return block
+}
+
+// writeFiles writes files to dir, creating it if needed. Existing files are
+// only replaced when force is set.
+func writeFiles(dir string, files map[string][]byte, force bool) error {
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	if !force {
+		for _, name := range names {
+			_, err := os.Stat(filepath.Join(dir, name))
+			if err == nil {
+				return fmt.Errorf("%s already exists, use -force to overwrite it", filepath.Join(dir, name))
+			}
+			if !errors.Is(err, fs.ErrNotExist) {
+				return err
+			}
+		}
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("unable to create %s: %w", dir, err)
+	}
+	for _, name := range names {
+		if err := os.WriteFile(filepath.Join(dir, name), files[name], 0o644); err != nil {
+			return fmt.Errorf("unable to write %s: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+// resource generates the resource block and import block of an object and
+// records the reference to its id for the resources that follow
+func (e *export) resource(ctx context.Context, resourceType, id, objectName string, state tfsdk.State) (*hclBlock, error) {
+	resourceSchema, ok := state.Schema.(schema.Schema)
+	if !ok {
+		return nil, fmt.Errorf("unexpected schema type %T for %s", state.Schema, resourceType)
+	}
+
+	localName := e.names.unique(resourceType, hclIdentifier(objectName, resourceType[len("uptime_"):]))
+	address := resourceType + "." + localName
+
+	block := newBlock("resource", resourceType, localName)
+	o := objectExport{export: e, kind: resourceType[len("uptime_"):], localName: localName, objectName: objectName}
+	if err := o.attributes(ctx, &block.body, resourceSchema.Attributes, state.Raw, ""); err != nil {
+		return nil, fmt.Errorf("unable to export %s %s: %w", resourceType, id, err)
+	}
+
+	importBlock := newBlock("import")
+	importBlock.body.set("to", hclRaw(address))
+	importBlock.body.set("id", hclRaw(hclString(id)))
+	e.imports = append(e.imports, importBlock)
+
+	if e.references[resourceType] == nil {
+		e.references[resourceType] = make(map[string]string)
+	}
+	e.references[resourceType][id] = address + ".id"
+
+	return block, nil
+}
+
+// blockNames holds the names taken by blocks, by block type
+type blockNames map[string]map[string]bool
+
+// unique returns name, or name with a numeric suffix if it is already
+// taken by another block of the same type, and marks it as taken
+func (n blockNames) unique(blockType, name string) string {
+	if n[blockType] == nil {
+		n[blockType] = make(map[string]bool)
+	}
+
+	unique := name
+	for i := 2; n[blockType][unique]; i++ {
+		unique = name + "_" + strconv.Itoa(i)
+	}
+	n[blockType][unique] = true
+
+	return unique
+}
+
+// objectExport converts the state of one object into configuration
+type objectExport struct {
+	export     *export
+	kind       string
+	localName  string
+	objectName string
+}
+
+// referencedTypes maps the top-level list attributes that hold object IDs
+// to the resource type of those objects
+var referencedTypes = map[string]string{
+	"contacts": "uptime_contact",
+	"monitors": "uptime_monitor",
+}
+
+// secretAttributes lists the attributes, by path, that hold credentials
+// without being marked sensitive in the schema. Like sensitive attributes,
+// they become variables rather than being written to disk.
+var secretAttributes = map[string]bool{
+	"slack_settings.webhook_url":   true,
+	"discord_settings.webhook_url": true,
+	"teams_settings.webhook_url":   true,
+}
+
+// secretKeys lists the map attributes, by path, whose values hold
+// credentials for some keys, e.g. a monitor's Authorization header. Keys are
+// lower case and matched case-insensitively.
+var secretKeys = map[string]map[string]bool{
+	"https_settings.request_headers": {"authorization": true, "cookie": true, "x-api-key": true},
+}
+
+// attributes adds the configurable attributes of an object value to body.
+// Computed-only attributes, null values and values equal to the attribute's
+// default are left out. parent is the path of the nested attribute holding
+// the object, or empty for the resource itself.
+func (o objectExport) attributes(ctx context.Context, body *hclBody, attributes map[string]schema.Attribute, value tftypes.Value, parent string) error {
+	var values map[string]tftypes.Value
+	if err := value.As(&values); err != nil {
+		return err
+	}
+
+	for _, name := range orderedNames(attributes) {
+		attribute := attributes[name]
+		v := values[name]
+
+		if v.IsNull() || (!attribute.IsRequired() && !attribute.IsOptional()) {
+			continue
+		}
+		if isDefault(ctx, attribute, v) {
+			continue
+		}
+
+		attributePath := name
+		if parent != "" {
+			attributePath = parent + "." + name
+		}
+
+		if attribute.IsSensitive() || secretAttributes[attributePath] {
+			body.set(name, o.variable(name, name))
+			continue
+		}
+
+		var expr hclValue
+		var err error
+		if resourceType, ok := referencedTypes[name]; ok && parent == "" {
+			expr, err = o.references(v, o.export.references[resourceType])
+		} else {
+			expr, err = o.value(ctx, attributePath, attribute, v)
+		}
+		if err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+		body.set(name, expr)
+	}
+
+	return nil
+}
+
+// value converts the value of the attribute at attributePath into an
+// expression
+func (o objectExport) value(ctx context.Context, attributePath string, attribute schema.Attribute, v tftypes.Value) (hclValue, error) {
+	switch attribute := attribute.(type) {
+	case schema.SingleNestedAttribute:
+		body := &hclBody{}
+		err := o.attributes(ctx, body, attribute.Attributes, v, attributePath)
+		return body, err
+
+	case schema.ListNestedAttribute:
+		var elements []tftypes.Value
+		if err := v.As(&elements); err != nil {
+			return nil, err
+		}
+		tuple := hclTuple{}
+		for _, element := range elements {
+			body := &hclBody{}
+			if err := o.attributes(ctx, body, attribute.NestedObject.Attributes, element, attributePath); err != nil {
+				return nil, err
+			}
+			tuple = append(tuple, body)
+		}
+		return tuple, nil
+
+	case schema.MapAttribute:
+		var elements map[string]tftypes.Value
+		if err := v.As(&elements); err != nil {
+			return nil, err
+		}
+		keys := make([]string, 0, len(elements))
+		for key := range elements {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		body := &hclBody{}
+		for _, key := range keys {
+			if secretKeys[attributePath][strings.ToLower(key)] {
+				name := attributePath[strings.LastIndex(attributePath, ".")+1:]
+				body.set(hclString(key), o.variable(name+"_"+hclIdentifier(key, "key"), fmt.Sprintf("%q in %s", key, name)))
+				continue
+			}
+
+			element, err := primitive(elements[key])
+			if err != nil {
+				return nil, err
+			}
+			body.set(hclString(key), element)
+		}
+		return body, nil
+
+	case schema.ListAttribute, schema.SetAttribute:
+		var elements []tftypes.Value
+		if err := v.As(&elements); err != nil {
+			return nil, err
+		}
+		tuple := hclTuple{}
+		for _, element := range elements {
+			expr, err := primitive(element)
+			if err != nil {
+				return nil, err
+			}
+			tuple = append(tuple, expr)
+		}
+		return tuple, nil
+	}
+
+	return primitive(v)
+}
+
+// references converts a list of object IDs into references to the exported
+// resources. IDs of objects that were not exported stay literal strings.
+func (o objectExport) references(v tftypes.Value, references map[string]string) (hclValue, error) {
+	var elements []tftypes.Value
+	if err := v.As(&elements); err != nil {
+		return nil, err
+	}
+
+	tuple := hclTuple{}
+	for _, element := range elements {
+		var id string
+		if err := element.As(&id); err != nil {
+			return nil, err
+		}
+		if reference, ok := references[id]; ok {
+			tuple = append(tuple, hclRaw(reference))
+		} else {
+			tuple = append(tuple, hclRaw(hclString(id)))
+		}
+	}
+	return tuple, nil
+}
+
+// variable declares a sensitive variable for a secret value and returns the
+// expression referring to it. The variable is named after suffix and
+// described as what of the object.
+func (o objectExport) variable(suffix, what string) hclValue {
+	name := o.export.names.unique("variable", o.kind+"_"+o.localName+"_"+suffix)
+
+	description := fmt.Sprintf("%s of the %s %q", what, kindDescription(o.kind), o.objectName)
+	o.export.variables = append(o.export.variables, sensitiveVariable(name, description))
+
+	return hclRaw("var." + name)
+}
+
+// primitive converts a string, number or bool value into a literal
+func primitive(v tftypes.Value) (hclValue, error) {
+	switch {
+	case v.Type().Is(tftypes.String):
+		var s string
+		if err := v.As(&s); err != nil {
+			return nil, err
+		}
+		return hclRaw(hclString(s)), nil
+	case v.Type().Is(tftypes.Number):
+		n := new(big.Float)
+		if err := v.As(&n); err != nil {
+			return nil, err
+		}
+		return hclRaw(n.Text('f', -1)), nil
+	case v.Type().Is(tftypes.Bool):
+		var b bool
+		if err := v.As(&b); err != nil {
+			return nil, err
+		}
+		return hclRaw(strconv.FormatBool(b)), nil
+	}
+	return nil, fmt.Errorf("unsupported value type %s", v.Type())
+}
+
+// isDefault reports whether v equals the static default of the attribute
+func isDefault(ctx context.Context, attribute schema.Attribute, v tftypes.Value) bool {
+	var def attr.Value
+	switch attribute := attribute.(type) {
+	case schema.BoolAttribute:
+		if attribute.Default == nil {
+			return false
+		}
+		var resp defaults.BoolResponse
+		attribute.Default.DefaultBool(ctx, defaults.BoolRequest{}, &resp)
+		def = resp.PlanValue
+	case schema.Int64Attribute:
+		if attribute.Default == nil {
+			return false
+		}
+		var resp defaults.Int64Response
+		attribute.Default.DefaultInt64(ctx, defaults.Int64Request{}, &resp)
+		def = resp.PlanValue
+	case schema.StringAttribute:
+		if attribute.Default == nil {
+			return false
+		}
+		var resp defaults.StringResponse
+		attribute.Default.DefaultString(ctx, defaults.StringRequest{}, &resp)
+		def = resp.PlanValue
+	default:
+		return false
+	}
+
+	defaultValue, err := def.ToTerraformValue(ctx)
+	return err == nil && defaultValue.Equal(v)
+}
+
+// omitDerivedHost clears the host and port of a monitor when they are the
+// ones the API derives from its URL, so the export only sets overrides
+func omitDerivedHost(monitor *client.Monitor) {
+	var rawURL string
+	switch {
+	case monitor.Settings.HTTPS != nil:
+		rawURL = monitor.Settings.HTTPS.URL
+	case monitor.Settings.TCP != nil:
+		rawURL = monitor.Settings.TCP.URL
+	default:
+		return
+	}
+
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return
+	}
+
+	if monitor.Host == u.Hostname() {
+		monitor.Host = ""
+	}
+
+	port := u.Port()
+	switch {
+	case port == "" && u.Scheme == "https":
+		port = "443"
+	case port == "" && u.Scheme == "http":
+		port = "80"
+	}
+	if strconv.Itoa(monitor.Port) == port {
+		monitor.Port = 0
+	}
+}
+
+// orderedNames returns the attribute names in the order they are written
+func orderedNames(attributes map[string]schema.Attribute) []string {
+	names := make([]string, 0, len(attributes))
+	for _, name := range attributeOrder {
+		if _, ok := attributes[name]; ok {
+			names = append(names, name)
+		}
+	}
+
+	var rest []string
+	for name := range attributes {
+		if !slices.Contains(attributeOrder, name) {
+			rest = append(rest, name)
+		}
+	}
+	sort.Strings(rest)
+
+	return append(names, rest...)
+}
+
+// kindDescription returns the kind of an object as used in messages
+func kindDescription(kind string) string {
+	if kind == "status_page" {
+		return "status page"
+	}
+	return kind
+}
+
+// count formats n with the singular or plural form of noun
+func count(n int, noun string) string {
+	if n == 1 {
+		return "1 " + noun
+	}
+	return strconv.Itoa(n) + " " + noun + "s"
+}
+
+// diagnosticsError returns the error diagnostics as an error
+func diagnosticsError(diags diag.Diagnostics) error {
+	var errs []error
+	for _, d := range diags.Errors() {
+		errs = append(errs, fmt.Errorf("%s: %s", d.Summary(), d.Detail()))
+	}
+	return errors.Join(errs...)
+}
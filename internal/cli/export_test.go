@@ -0,0 +1,259 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"terraform-provider-uptime/internal/client"
+)
+
+// accountServer serves a small account with three contacts, two monitors
+// and a status page
+func accountServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	return prefixedAccountServer(t, "")
+}
+
+// prefixedAccountServer serves the account of accountServer with prefix
+// added to every name. With a prefix, it also serves a monitor of another
+// environment that shares a name with one of them.
+func prefixedAccountServer(t *testing.T, prefix string) *httptest.Server {
+	t.Helper()
+
+	headers := "Accept: application/json\nAuthorization: Bearer t0ken\nX-Api-Version: 2"
+	basicAuth := "viewer:s3cret"
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/api/contacts":
+			_ = json.NewEncoder(w).Encode(client.ListContactsResponse{
+				Status: "ok",
+				Data: &client.ListContactsData{Contacts: []client.Contact{
+					{ID: "c1", Name: prefix + "Ops Email", Channel: "email", Details: json.RawMessage(`{"email":"ops@example.com"}`), Active: true},
+					{ID: "c2", Name: prefix + "Ops PagerDuty", Channel: "pagerduty", Details: json.RawMessage(`{"integration_key":"abc123","auto_resolve_incidents":false}`), Active: true, DownAlertsOnly: true},
+					{ID: "c3", Name: prefix + "Ops Slack", Channel: "slack", Details: json.RawMessage(`{"webhook_url":"https://hooks.slack.com/services/T000/B000/xoxsecret"}`), Active: true},
+				}},
+			})
+		case "/api/monitors":
+			monitors := []client.Monitor{
+				{
+					ID: "m1", Name: prefix + "Checkout API", Active: true, CheckInterval: 60, Timeout: 30, FailThreshold: 2,
+					Regions: []string{"us-east-1", "eu-west-1"}, Contacts: []string{"c1", "c2"},
+					Settings: client.MonitorSettings{HTTPS: &client.HTTPSSettings{
+						URL: "https://shop.example.com/health", RequestHeaders: &headers,
+						CheckCertificateExpiration: true, FollowRedirect: true,
+					}},
+					Host: "shop.example.com", Port: 443,
+				},
+				{
+					ID: "m2", Name: prefix + "Checkout DB", Active: false, CheckInterval: 120, Timeout: 10, FailThreshold: 1,
+					Regions:  []string{"us-east-1"},
+					Settings: client.MonitorSettings{TCP: &client.TCPSettings{URL: "tcp://db.example.com:5432"}},
+					Host:     "db.example.com", Port: 5432,
+				},
+			}
+			if prefix != "" {
+				monitors = append(monitors, client.Monitor{
+					ID: "m-other", Name: "Checkout API", Active: true, CheckInterval: 60, Timeout: 30, FailThreshold: 1,
+					Settings: client.MonitorSettings{HTTPS: &client.HTTPSSettings{URL: "https://shop.example.com/health"}},
+				})
+			}
+			_ = json.NewEncoder(w).Encode(client.ListMonitorsResponse{
+				Status: "ok",
+				Data:   &client.ListMonitorsData{Monitors: monitors},
+			})
+		case "/api/status_pages":
+			_ = json.NewEncoder(w).Encode(client.ListStatusPagesResponse{
+				Status: "ok",
+				Data: &client.ListStatusPagesData{StatusPages: []client.StatusPage{
+					{ID: "s1", Name: prefix + "Shop Status", Monitors: []string{"m1", "m2"}, Period: 30, BasicAuth: &basicAuth},
+				}},
+			})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestExportAccount(t *testing.T) {
+	server := accountServer(t)
+
+	e, err := exportAccount(context.Background(), client.NewClient(server.URL, "key"))
+	require.NoError(t, err)
+	files := e.files()
+
+	assert.Equal(t, `resource "uptime_contact" "ops_email" {
+  name    = "Ops Email"
+  channel = "email"
+
+  email_settings = {
+    email = "ops@example.com"
+  }
+}
+
+resource "uptime_contact" "ops_pagerduty" {
+  name             = "Ops PagerDuty"
+  channel          = "pagerduty"
+  down_alerts_only = true
+
+  pagerduty_settings = {
+    auto_resolve_incidents = false
+    integration_key        = var.contact_ops_pagerduty_integration_key
+  }
+}
+
+resource "uptime_contact" "ops_slack" {
+  name    = "Ops Slack"
+  channel = "slack"
+
+  slack_settings = {
+    webhook_url = var.contact_ops_slack_webhook_url
+  }
+}
+`, string(files["contacts.tf"]))
+
+	assert.Equal(t, `resource "uptime_monitor" "checkout_api" {
+  name           = "Checkout API"
+  url            = "https://shop.example.com/health"
+  type           = "https"
+  check_interval = 60
+  timeout        = 30
+  fail_threshold = 2
+  regions        = ["us-east-1", "eu-west-1"]
+  contacts       = [uptime_contact.ops_email.id, uptime_contact.ops_pagerduty.id]
+
+  https_settings = {
+    request_headers = {
+      "Accept"        = "application/json"
+      "Authorization" = var.monitor_checkout_api_request_headers_authorization
+      "X-Api-Version" = "2"
+    }
+  }
+}
+
+resource "uptime_monitor" "checkout_db" {
+  name           = "Checkout DB"
+  url            = "tcp://db.example.com:5432"
+  type           = "tcp"
+  check_interval = 120
+  timeout        = 10
+  fail_threshold = 1
+  regions        = ["us-east-1"]
+  active         = false
+  tcp_settings   = {}
+}
+`, string(files["monitors.tf"]))
+
+	assert.Equal(t, `resource "uptime_status_page" "shop_status" {
+  name       = "Shop Status"
+  monitors   = [uptime_monitor.checkout_api.id, uptime_monitor.checkout_db.id]
+  basic_auth = var.status_page_shop_status_basic_auth
+  period     = 30
+}
+`, string(files["status_pages.tf"]))
+
+	assert.Contains(t, string(files["imports.tf"]), `import {
+  to = uptime_monitor.checkout_db
+  id = "m2"
+}
+`)
+
+	assert.Equal(t, `variable "contact_ops_pagerduty_integration_key" {
+  description = "integration_key of the contact \"Ops PagerDuty\""
+  type        = string
+  sensitive   = true
+}
+
+variable "contact_ops_slack_webhook_url" {
+  description = "webhook_url of the contact \"Ops Slack\""
+  type        = string
+  sensitive   = true
+}
+
+variable "monitor_checkout_api_request_headers_authorization" {
+  description = "\"Authorization\" in request_headers of the monitor \"Checkout API\""
+  type        = string
+  sensitive   = true
+}
+
+variable "status_page_shop_status_basic_auth" {
+  description = "basic_auth of the status page \"Shop Status\""
+  type        = string
+  sensitive   = true
+}
+`, string(files["variables.tf"]))
+
+	for _, content := range files {
+		assert.NotContains(t, string(content), "abc123")
+		assert.NotContains(t, string(content), "s3cret")
+		assert.NotContains(t, string(content), "t0ken")
+		assert.NotContains(t, string(content), "xoxsecret")
+	}
+}
+
+func TestExport_WriteFiles(t *testing.T) {
+	server := accountServer(t)
+	t.Setenv("UPTIME_API_KEY", "key")
+	t.Setenv("UPTIME_CONFIG_FILE", filepath.Join(t.TempDir(), "credentials"))
+	out := filepath.Join(t.TempDir(), "exported")
+
+	var stdout, stderr bytes.Buffer
+	code := Run(context.Background(), []string{"export", "-out", out, "-base-url", server.URL}, &stdout, &stderr)
+	require.Equal(t, exitOK, code, stderr.String())
+	assert.Contains(t, stdout.String(), "Exported 2 monitors, 3 contacts and 1 status page")
+
+	for _, name := range []string{"terraform.tf", "contacts.tf", "monitors.tf", "status_pages.tf", "imports.tf", "variables.tf"} {
+		assert.FileExists(t, filepath.Join(out, name))
+	}
+
+	// A second export refuses to replace the files unless forced
+	stderr.Reset()
+	code = Run(context.Background(), []string{"export", "-out", out, "-base-url", server.URL}, &stdout, &stderr)
+	assert.Equal(t, exitFailure, code)
+	assert.Contains(t, stderr.String(), "already exists")
+
+	require.NoError(t, os.WriteFile(filepath.Join(out, "monitors.tf"), nil, 0o644))
+	code = Run(context.Background(), []string{"export", "-out", out, "-base-url", server.URL, "-force"}, &stdout, &stderr)
+	assert.Equal(t, exitOK, code)
+	content, err := os.ReadFile(filepath.Join(out, "monitors.tf"))
+	require.NoError(t, err)
+	assert.Contains(t, string(content), `resource "uptime_monitor" "checkout_api"`)
+}
+
+func TestExport_NamePrefix(t *testing.T) {
+	server := prefixedAccountServer(t, "[staging] ")
+	t.Setenv("UPTIME_API_KEY", "key")
+	t.Setenv("UPTIME_CONFIG_FILE", filepath.Join(t.TempDir(), "credentials"))
+	out := filepath.Join(t.TempDir(), "exported")
+
+	var stdout, stderr bytes.Buffer
+	code := Run(context.Background(), []string{"export", "-out", out, "-base-url", server.URL, "-name-prefix", "[staging] "}, &stdout, &stderr)
+	require.Equal(t, exitOK, code, stderr.String())
+	assert.Contains(t, stdout.String(), "Exported 2 monitors, 3 contacts and 1 status page")
+
+	content, err := os.ReadFile(filepath.Join(out, "monitors.tf"))
+	require.NoError(t, err)
+	assert.Contains(t, string(content), `name           = "Checkout API"`)
+	assert.NotContains(t, string(content), "[staging]")
+	assert.NotContains(t, string(content), "checkout_api_2")
+}
+
+func TestExport_RequiresOut(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	assert.Equal(t, exitUsage, Run(context.Background(), []string{"export"}, &stdout, &stderr))
+	assert.Contains(t, stderr.String(), "Usage: terraform-provider-uptime export")
+}
@@ -0,0 +1,229 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// maxLineLength is the length above which a tuple of plain values is split
+// over several lines
+const maxLineLength = 100
+
+// hclValue is an HCL expression
+type hclValue interface {
+	// multiline reports whether the expression is written over several lines
+	multiline() bool
+
+	// write renders the expression, with continuation lines at indent
+	write(b *strings.Builder, indent int)
+}
+
+// hclRaw is an expression written as is, such as a number, a quoted string
+// or a reference
+type hclRaw string
+
+func (v hclRaw) multiline() bool { return false }
+
+func (v hclRaw) write(b *strings.Builder, indent int) { b.WriteString(string(v)) }
+
+// hclTuple is a tuple constructor
+type hclTuple []hclValue
+
+func (v hclTuple) multiline() bool {
+	var b strings.Builder
+	for _, element := range v {
+		if element.multiline() {
+			return true
+		}
+		element.write(&b, 0)
+		b.WriteString(", ")
+	}
+	return b.Len() > maxLineLength
+}
+
+func (v hclTuple) write(b *strings.Builder, indent int) {
+	if !v.multiline() {
+		b.WriteString("[")
+		for i, element := range v {
+			if i > 0 {
+				b.WriteString(", ")
+			}
+			element.write(b, indent)
+		}
+		b.WriteString("]")
+		return
+	}
+
+	b.WriteString("[\n")
+	for _, element := range v {
+		writeIndent(b, indent+1)
+		element.write(b, indent+1)
+		b.WriteString(",\n")
+	}
+	writeIndent(b, indent)
+	b.WriteString("]")
+}
+
+// hclBody is a block body or object constructor. Attributes keep the order
+// they were added in, except that single-line attributes are written first
+// with their equals signs aligned, and each multi-line attribute follows
+// after a blank line, like terraform fmt lays out hand-written files.
+type hclBody struct {
+	attributes []hclAttribute
+}
+
+// hclAttribute is a named attribute of a body
+type hclAttribute struct {
+	name  string
+	value hclValue
+}
+
+// set adds an attribute to the body
+func (v *hclBody) set(name string, value hclValue) {
+	v.attributes = append(v.attributes, hclAttribute{name: name, value: value})
+}
+
+func (v *hclBody) multiline() bool { return len(v.attributes) > 0 }
+
+func (v *hclBody) write(b *strings.Builder, indent int) {
+	if len(v.attributes) == 0 {
+		b.WriteString("{}")
+		return
+	}
+
+	b.WriteString("{\n")
+	v.writeAttributes(b, indent+1)
+	writeIndent(b, indent)
+	b.WriteString("}")
+}
+
+// writeAttributes writes the attributes of the body at indent
+func (v *hclBody) writeAttributes(b *strings.Builder, indent int) {
+	width := 0
+	for _, attribute := range v.attributes {
+		if !attribute.value.multiline() {
+			width = max(width, len(attribute.name))
+		}
+	}
+
+	written := false
+	for _, attribute := range v.attributes {
+		if attribute.value.multiline() {
+			continue
+		}
+		writeIndent(b, indent)
+		fmt.Fprintf(b, "%-*s = ", width, attribute.name)
+		attribute.value.write(b, indent)
+		b.WriteString("\n")
+		written = true
+	}
+
+	for _, attribute := range v.attributes {
+		if !attribute.value.multiline() {
+			continue
+		}
+		if written {
+			b.WriteString("\n")
+		}
+		writeIndent(b, indent)
+		b.WriteString(attribute.name + " = ")
+		attribute.value.write(b, indent)
+		b.WriteString("\n")
+		written = true
+	}
+}
+
+// hclBlock is a top-level block such as resource "type" "name" { ... }
+type hclBlock struct {
+	labels []string
+	body   hclBody
+}
+
+// newBlock returns a block of the given type and labels
+func newBlock(blockType string, labels ...string) *hclBlock {
+	return &hclBlock{labels: append([]string{blockType}, labels...)}
+}
+
+// hclFile renders blocks separated by blank lines
+func hclFile(blocks []*hclBlock) []byte {
+	var b strings.Builder
+	for i, block := range blocks {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+
+		b.WriteString(block.labels[0])
+		for _, label := range block.labels[1:] {
+			b.WriteString(" " + hclString(label))
+		}
+		b.WriteString(" {\n")
+		block.body.writeAttributes(&b, 1)
+		b.WriteString("}\n")
+	}
+	return []byte(b.String())
+}
+
+// hclString quotes s as an HCL string literal, escaping template sequences
+// so that the text is taken literally
+func hclString(s string) string {
+	var b strings.Builder
+	b.WriteString(`"`)
+	for i, r := range s {
+		switch {
+		case r == '"':
+			b.WriteString(`\"`)
+		case r == '\\':
+			b.WriteString(`\\`)
+		case r == '\n':
+			b.WriteString(`\n`)
+		case r == '\r':
+			b.WriteString(`\r`)
+		case r == '\t':
+			b.WriteString(`\t`)
+		case (r == '$' || r == '%') && strings.HasPrefix(s[i+1:], "{"):
+			b.WriteRune(r)
+			b.WriteRune(r)
+		case !unicode.IsPrint(r):
+			fmt.Fprintf(&b, `\u%04x`, r)
+		default:
+			b.WriteRune(r)
+		}
+	}
+	b.WriteString(`"`)
+	return b.String()
+}
+
+// hclIdentifier converts text, such as an object name, into an identifier
+// usable as a resource or variable name. Runs of other characters become
+// underscores, and fallback is used when nothing is left or prepended when
+// the result would start with a digit.
+func hclIdentifier(text, fallback string) string {
+	var b strings.Builder
+	underscore := false
+	for _, r := range strings.ToLower(text) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			if underscore && b.Len() > 0 {
+				b.WriteString("_")
+			}
+			b.WriteRune(r)
+			underscore = false
+			continue
+		}
+		underscore = true
+	}
+
+	identifier := b.String()
+	switch {
+	case identifier == "":
+		return fallback
+	case identifier[0] >= '0' && identifier[0] <= '9':
+		return fallback + "_" + identifier
+	}
+	return identifier
+}
+
+// writeIndent writes two spaces per indentation level
+func writeIndent(b *strings.Builder, indent int) {
+	b.WriteString(strings.Repeat("  ", indent))
+}
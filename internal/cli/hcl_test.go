@@ -0,0 +1,52 @@
+package cli
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHCLString(t *testing.T) {
+	tests := map[string]string{
+		`plain`:                `"plain"`,
+		`say "hi"`:             `"say \"hi\""`,
+		`C:\path`:              `"C:\\path"`,
+		"two\nlines":           `"two\nlines"`,
+		"${var.x} and %{ if }": `"$${var.x} and %%{ if }"`,
+		"$5 and 50%":           `"$5 and 50%"`,
+		"bell\a":               `"bell\u0007"`,
+	}
+
+	for input, expected := range tests {
+		assert.Equal(t, expected, hclString(input), input)
+	}
+}
+
+func TestHCLIdentifier(t *testing.T) {
+	tests := map[string]string{
+		"Checkout API":        "checkout_api",
+		"  API -- (prod)  ":   "api_prod",
+		"already_snake_case":  "already_snake_case",
+		"24/7 Support":        "monitor_24_7_support",
+		"!!!":                 "monitor",
+		"Überwachung Shop":    "berwachung_shop",
+		"status.example.com":  "status_example_com",
+		"Mixed_Case-Name 2nd": "mixed_case_name_2nd",
+	}
+
+	for input, expected := range tests {
+		assert.Equal(t, expected, hclIdentifier(input, "monitor"), input)
+	}
+}
+
+func TestHCLTuple_LongListsSplit(t *testing.T) {
+	var tuple hclTuple
+	for range 6 {
+		tuple = append(tuple, hclRaw("uptime_contact.a_fairly_long_contact_name.id"))
+	}
+
+	var b strings.Builder
+	tuple.write(&b, 1)
+	assert.Equal(t, "[\n"+strings.Repeat("    uptime_contact.a_fairly_long_contact_name.id,\n", 6)+"  ]", b.String())
+}
@@ -0,0 +1,317 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Export formats read by migrate
+const (
+	formatUptimeRobot = "uptimerobot"
+	formatBetterStack = "betterstack"
+)
+
+// migrationReportFile is the name of the report written next to the
+// generated configuration
+const migrationReportFile = "migration-report.txt"
+
+func runMigrate(ctx context.Context, args []string, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("migrate", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	in := flags.String("in", "", "JSON export of the other service (required)")
+	out := flags.String("out", "", "directory to write the configuration and report to (required)")
+	from := flags.String("from", "", "format of the export: uptimerobot or betterstack (detected if not set)")
+	force := flags.Bool("force", false, "overwrite files that already exist in the output directory")
+	flags.Usage = func() {
+		_, _ = fmt.Fprintln(stderr, "Usage: terraform-provider-uptime migrate -in <file> -out <dir> [flags]")
+		_, _ = fmt.Fprintln(stderr)
+		_, _ = fmt.Fprintln(stderr, "Converts the monitors and alert contacts of an UptimeRobot or Better Stack")
+		_, _ = fmt.Fprintln(stderr, "JSON export into uptime_monitor and uptime_contact resources, and lists the")
+		_, _ = fmt.Fprintf(stderr, "settings that could not be translated in %s.\n", migrationReportFile)
+		_, _ = fmt.Fprintln(stderr)
+		_, _ = fmt.Fprintln(stderr, "UptimeRobot: the response of getMonitors (with alert_contacts=1), optionally")
+		_, _ = fmt.Fprintln(stderr, "with the alert_contacts array of getAlertContacts merged in.")
+		_, _ = fmt.Fprintln(stderr, "Better Stack: the response of GET /api/v2/monitors.")
+		_, _ = fmt.Fprintln(stderr)
+		flags.PrintDefaults()
+	}
+
+	if err := flags.Parse(args); err != nil {
+		return exitUsage
+	}
+	if *in == "" || *out == "" || flags.NArg() > 0 {
+		flags.Usage()
+		return exitUsage
+	}
+
+	data, err := os.ReadFile(*in)
+	if err != nil {
+		_, _ = fmt.Fprintf(stderr, "Error: %s\n", err)
+		return exitFailure
+	}
+
+	format := *from
+	if format == "" {
+		format, err = detectExportFormat(data)
+		if err != nil {
+			_, _ = fmt.Fprintf(stderr, "Error: %s: %s\n", *in, err)
+			return exitFailure
+		}
+	}
+
+	m := newMigration(format, *in)
+	switch format {
+	case formatUptimeRobot:
+		err = m.fromUptimeRobot(data)
+	case formatBetterStack:
+		err = m.fromBetterStack(data)
+	default:
+		flags.Usage()
+		return exitUsage
+	}
+	if err != nil {
+		_, _ = fmt.Fprintf(stderr, "Error: %s: %s\n", *in, err)
+		return exitFailure
+	}
+
+	if err := writeFiles(*out, m.files(), *force); err != nil {
+		_, _ = fmt.Fprintf(stderr, "Error: %s\n", err)
+		return exitFailure
+	}
+
+	_, _ = fmt.Fprintf(stdout, "Converted %s and %s to %s\n", count(len(m.monitors), "monitor"), count(len(m.contacts), "contact"), *out)
+	if len(m.notes) > 0 {
+		_, _ = fmt.Fprintf(stdout, "%s could not be translated, see %s\n", count(len(m.notes), "setting"), migrationReportFile)
+	}
+	if len(m.variables) > 0 {
+		_, _ = fmt.Fprintf(stdout, "Set the %d secret variables declared in variables.tf before running terraform plan\n", len(m.variables))
+	}
+
+	return exitOK
+}
+
+// detectExportFormat recognizes an export by its top-level keys
+func detectExportFormat(data []byte) (string, error) {
+	var top map[string]json.RawMessage
+	if err := json.Unmarshal(data, &top); err != nil {
+		return "", fmt.Errorf("not a JSON object: %w", err)
+	}
+
+	switch {
+	case top["monitors"] != nil:
+		return formatUptimeRobot, nil
+	case top["data"] != nil:
+		return formatBetterStack, nil
+	}
+	return "", fmt.Errorf("unrecognized export: expected a \"monitors\" (UptimeRobot) or \"data\" (Better Stack) key, or set -from")
+}
+
+// migration holds the configuration converted from another service
+type migration struct {
+	format string
+	input  string
+
+	contacts  []*hclBlock
+	monitors  []*hclBlock
+	variables []*hclBlock
+	notes     []migrationNote
+
+	names blockNames
+
+	// contactReferences maps the source service's contact IDs to the
+	// reference expression of the converted contact's id
+	contactReferences map[string]string
+}
+
+// migrationNote is a setting that could not be translated
+type migrationNote struct {
+	object  string
+	setting string
+	reason  string
+}
+
+// newMigration returns an empty migration from an export of format
+func newMigration(format, input string) *migration {
+	return &migration{
+		format:            format,
+		input:             input,
+		names:             blockNames{},
+		contactReferences: make(map[string]string),
+	}
+}
+
+// note records a setting of object that could not be translated
+func (m *migration) note(object, setting, reason string) {
+	m.notes = append(m.notes, migrationNote{object: object, setting: setting, reason: reason})
+}
+
+// addContact adds an uptime_contact resource. settings holds the attributes
+// of the channel's settings object; secrets lists the ones to replace with
+// variables. It returns the reference to the contact's id.
+func (m *migration) addContact(sourceID, name, channel string, settings map[string]string, secrets ...string) string {
+	localName := m.names.unique("uptime_contact", hclIdentifier(name, "contact"))
+
+	block := newBlock("resource", "uptime_contact", localName)
+	block.body.set("name", hclRaw(hclString(name)))
+	block.body.set("channel", hclRaw(hclString(channel)))
+
+	body := &hclBody{}
+	for _, key := range sortedKeys(settings) {
+		if slices.Contains(secrets, key) {
+			variable := m.names.unique("variable", "contact_"+localName+"_"+key)
+			m.variables = append(m.variables, sensitiveVariable(variable, fmt.Sprintf("%s of the contact %q", key, name)))
+			body.set(key, hclRaw("var."+variable))
+			continue
+		}
+		body.set(key, hclRaw(hclString(settings[key])))
+	}
+	block.body.set(channel+"_settings", body)
+
+	m.contacts = append(m.contacts, block)
+
+	reference := "uptime_contact." + localName + ".id"
+	m.contactReferences[sourceID] = reference
+	return reference
+}
+
+// migratedMonitor holds the settings of a converted monitor
+type migratedMonitor struct {
+	name          string
+	url           string
+	monitorType   string
+	checkInterval int
+	timeout       int
+	paused        bool
+	contacts      []string
+
+	// https holds the https_settings attributes as expressions
+	https *hclBody
+}
+
+// addMonitor adds an uptime_monitor resource
+func (m *migration) addMonitor(monitor migratedMonitor) {
+	localName := m.names.unique("uptime_monitor", hclIdentifier(monitor.name, "monitor"))
+
+	block := newBlock("resource", "uptime_monitor", localName)
+	block.body.set("name", hclRaw(hclString(monitor.name)))
+	block.body.set("url", hclRaw(hclString(monitor.url)))
+	block.body.set("type", hclRaw(hclString(monitor.monitorType)))
+	if monitor.checkInterval > 0 {
+		block.body.set("check_interval", hclRaw(strconv.Itoa(monitor.checkInterval)))
+	}
+	if monitor.timeout > 0 {
+		block.body.set("timeout", hclRaw(strconv.Itoa(monitor.timeout)))
+	}
+	if len(monitor.contacts) > 0 {
+		contacts := hclTuple{}
+		for _, contact := range monitor.contacts {
+			contacts = append(contacts, hclRaw(contact))
+		}
+		block.body.set("contacts", contacts)
+	}
+	if monitor.paused {
+		block.body.set("active", hclRaw("false"))
+	}
+
+	switch monitor.monitorType {
+	case "https":
+		if monitor.https != nil && len(monitor.https.attributes) > 0 {
+			block.body.set("https_settings", monitor.https)
+		}
+	case "tcp":
+		block.body.set("tcp_settings", &hclBody{})
+	case "ping":
+		block.body.set("ping_settings", &hclBody{})
+	}
+
+	m.monitors = append(m.monitors, block)
+}
+
+// files returns the generated configuration and report by name
+func (m *migration) files() map[string][]byte {
+	files := map[string][]byte{
+		"terraform.tf":      hclFile([]*hclBlock{terraformBlock()}),
+		migrationReportFile: m.report(),
+	}
+	for name, blocks := range map[string][]*hclBlock{
+		"contacts.tf":  m.contacts,
+		"monitors.tf":  m.monitors,
+		"variables.tf": m.variables,
+	} {
+		if len(blocks) > 0 {
+			files[name] = hclFile(blocks)
+		}
+	}
+	return files
+}
+
+// report lists the settings that could not be translated, grouped by object
+func (m *migration) report() []byte {
+	var b strings.Builder
+
+	source := map[string]string{formatUptimeRobot: "UptimeRobot", formatBetterStack: "Better Stack"}[m.format]
+	fmt.Fprintf(&b, "Migration of the %s export %s\n\n", source, m.input)
+	fmt.Fprintf(&b, "Converted %s and %s.\n\n", count(len(m.monitors), "monitor"), count(len(m.contacts), "contact"))
+
+	if len(m.notes) == 0 {
+		b.WriteString("Every setting was translated.\n")
+		return []byte(b.String())
+	}
+
+	b.WriteString("These settings could not be translated and need attention:\n")
+	object := ""
+	for _, note := range m.notes {
+		if note.object != object {
+			object = note.object
+			fmt.Fprintf(&b, "\n%s\n", object)
+		}
+		fmt.Fprintf(&b, "  - %s: %s\n", note.setting, note.reason)
+	}
+
+	return []byte(b.String())
+}
+
+// flexString decodes a JSON string or number into a string, as exports
+// encode IDs and ports either way
+type flexString string
+
+func (s *flexString) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var text string
+	if err := json.Unmarshal(data, &text); err == nil {
+		*s = flexString(text)
+		return nil
+	}
+	var number json.Number
+	if err := json.Unmarshal(data, &number); err != nil {
+		return fmt.Errorf("expected a string or number, got %s", data)
+	}
+	*s = flexString(number.String())
+	return nil
+}
+
+// int returns the value as a number, or zero if it is not one
+func (s flexString) int() int {
+	n, _ := strconv.Atoi(strings.TrimSpace(string(s)))
+	return n
+}
+
+// sortedKeys returns the keys of a map in order
+func sortedKeys(values map[string]string) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
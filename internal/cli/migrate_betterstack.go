@@ -0,0 +1,197 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// betterStackExport is the response of the Better Stack monitors API
+type betterStackExport struct {
+	Data []struct {
+		ID         flexString         `json:"id"`
+		Type       string             `json:"type"`
+		Attributes betterStackMonitor `json:"attributes"`
+	} `json:"data"`
+}
+
+type betterStackMonitor struct {
+	PronounceableName   string   `json:"pronounceable_name"`
+	URL                 string   `json:"url"`
+	MonitorType         string   `json:"monitor_type"`
+	RequiredKeyword     string   `json:"required_keyword"`
+	ExpectedStatusCodes []int    `json:"expected_status_codes"`
+	CheckFrequency      int      `json:"check_frequency"`
+	RequestTimeout      int      `json:"request_timeout"`
+	Regions             []string `json:"regions"`
+	HTTPMethod          string   `json:"http_method"`
+	RequestHeaders      []struct {
+		Name  string `json:"name"`
+		Value string `json:"value"`
+	} `json:"request_headers"`
+	RequestBody        string     `json:"request_body"`
+	FollowRedirects    *bool      `json:"follow_redirects"`
+	VerifySSL          *bool      `json:"verify_ssl"`
+	SSLExpiration      *int       `json:"ssl_expiration"`
+	Port               flexString `json:"port"`
+	Paused             bool       `json:"paused"`
+	ConfirmationPeriod int        `json:"confirmation_period"`
+	Email              bool       `json:"email"`
+	SMS                bool       `json:"sms"`
+	Call               bool       `json:"call"`
+	Push               bool       `json:"push"`
+}
+
+// betterStackPorts are the default ports of the Better Stack monitor types
+// that are converted into TCP monitors
+var betterStackPorts = map[string]int{"tcp": 0, "smtp": 25, "pop": 110, "imap": 143}
+
+// fromBetterStack converts a Better Stack export. Better Stack alerts team
+// members rather than contacts, so no contacts are created.
+func (m *migration) fromBetterStack(data []byte) error {
+	var export betterStackExport
+	if err := json.Unmarshal(data, &export); err != nil {
+		return fmt.Errorf("unable to parse Better Stack export: %w", err)
+	}
+
+	for _, resource := range export.Data {
+		if resource.Type != "" && resource.Type != "monitor" {
+			continue
+		}
+		m.betterStackMonitor(string(resource.ID), resource.Attributes)
+	}
+
+	return nil
+}
+
+// betterStackMonitor converts a monitor, recording notes for the settings
+// that cannot be translated
+func (m *migration) betterStackMonitor(id string, source betterStackMonitor) {
+	name := source.PronounceableName
+	if name == "" {
+		name = source.URL
+	}
+	object := fmt.Sprintf("monitor %q (id %s)", name, id)
+
+	monitor := migratedMonitor{
+		name:          name,
+		url:           source.URL,
+		checkInterval: source.CheckFrequency,
+		timeout:       source.RequestTimeout,
+		paused:        source.Paused,
+	}
+
+	switch source.MonitorType {
+	case "status", "expected_status_code", "keyword", "keyword_absence":
+		monitor.monitorType = "https"
+		monitor.https = m.betterStackHTTPSettings(object, source)
+
+	case "ping":
+		monitor.monitorType = "ping"
+		monitor.url = hostOf(source.URL)
+		// The request timeout of ping monitors is in milliseconds
+		monitor.timeout = (source.RequestTimeout + 999) / 1000
+
+	case "tcp", "smtp", "pop", "imap":
+		port := source.Port.int()
+		if port == 0 {
+			port = betterStackPorts[source.MonitorType]
+		}
+		if port == 0 {
+			m.note(object, "port", "the monitor has no port; the monitor was skipped")
+			return
+		}
+		if source.MonitorType != "tcp" {
+			m.note(object, "monitor_type", fmt.Sprintf("%s checks become TCP checks that only test whether port %d accepts connections", strings.ToUpper(source.MonitorType), port))
+		}
+		monitor.monitorType = "tcp"
+		monitor.url = "tcp://" + net.JoinHostPort(hostOf(source.URL), strconv.Itoa(port))
+
+	default:
+		m.note(object, "monitor_type", fmt.Sprintf("%q monitors are not supported; the monitor was skipped", source.MonitorType))
+		return
+	}
+
+	if len(source.Regions) > 0 {
+		m.note(object, "regions", fmt.Sprintf("regions %s have no direct equivalent; set regions or use the provider's monitor_defaults", strings.Join(source.Regions, ", ")))
+	}
+	if source.ConfirmationPeriod > 0 {
+		m.note(object, "confirmation_period", fmt.Sprintf("the %ds confirmation period is not supported; use fail_threshold to require several failed checks", source.ConfirmationPeriod))
+	}
+
+	var alerts []string
+	for channel, enabled := range map[string]bool{"email": source.Email, "sms": source.SMS, "call": source.Call, "push": source.Push} {
+		if enabled {
+			alerts = append(alerts, channel)
+		}
+	}
+	if len(alerts) > 0 {
+		sort.Strings(alerts)
+		m.note(object, "alerts", fmt.Sprintf("Better Stack notified team members by %s; create uptime_contact resources and add them to contacts", strings.Join(alerts, ", ")))
+	}
+
+	m.addMonitor(monitor)
+}
+
+// betterStackHTTPSettings converts the settings of status, status code and
+// keyword monitors
+func (m *migration) betterStackHTTPSettings(object string, source betterStackMonitor) *hclBody {
+	settings := &hclBody{}
+
+	method := strings.ToUpper(source.HTTPMethod)
+	switch source.MonitorType {
+	case "keyword":
+		// The response body is needed to look for the keyword
+		if method == "" || method == "HEAD" {
+			method = "GET"
+		}
+		settings.set("expected_response_body", hclRaw(hclString(source.RequiredKeyword)))
+	case "keyword_absence":
+		m.note(object, "required_keyword", fmt.Sprintf("alerting when %q is present is not supported, only when an expected text is missing", source.RequiredKeyword))
+	case "expected_status_code":
+		codes := make([]string, 0, len(source.ExpectedStatusCodes))
+		for _, code := range source.ExpectedStatusCodes {
+			codes = append(codes, strconv.Itoa(code))
+		}
+		if len(codes) > 0 {
+			settings.set("expected_status_codes", hclRaw(hclString(strings.Join(codes, ","))))
+		}
+	}
+	if method != "" && method != "HEAD" {
+		settings.set("method", hclRaw(hclString(method)))
+	}
+
+	if len(source.RequestHeaders) > 0 {
+		headers := make(map[string]string, len(source.RequestHeaders))
+		for _, header := range source.RequestHeaders {
+			headers[header.Name] = header.Value
+		}
+		body := &hclBody{}
+		for _, name := range sortedKeys(headers) {
+			body.set(hclString(name), hclRaw(hclString(headers[name])))
+		}
+		settings.set("request_headers", body)
+	}
+
+	if source.RequestBody != "" {
+		settings.set("request_body", hclRaw(hclString(source.RequestBody)))
+	}
+
+	if source.FollowRedirects != nil && !*source.FollowRedirects {
+		settings.set("follow_redirects", hclRaw("false"))
+	}
+	if source.SSLExpiration == nil && strings.HasPrefix(source.URL, "https://") {
+		settings.set("check_certificate_expiration", hclRaw("false"))
+	}
+	if source.SSLExpiration != nil {
+		m.note(object, "ssl_expiration", fmt.Sprintf("alerting %d days before certificate expiry cannot be configured; check_certificate_expiration is left enabled", *source.SSLExpiration))
+	}
+	if source.VerifySSL != nil && !*source.VerifySSL {
+		m.note(object, "verify_ssl", "skipping certificate verification is not supported; the check fails on invalid certificates")
+	}
+
+	return settings
+}
@@ -0,0 +1,221 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const uptimeRobotFixture = `{
+  "stat": "ok",
+  "monitors": [
+    {
+      "id": 777001, "friendly_name": "Shop Home", "url": "https://shop.example.com", "type": 2,
+      "keyword_type": 2, "keyword_value": "Welcome", "keyword_case_type": 1, "http_method": 1,
+      "interval": 300, "timeout": 30, "status": 2,
+      "custom_http_headers": {"X-Check": "uptime"},
+      "custom_http_statuses": "200:1_204:1_503:0",
+      "alert_contacts": [
+        {"id": "501", "type": 2, "value": "ops@example.com", "threshold": 0, "recurrence": 0},
+        {"id": "502", "type": 16, "value": "pd-secret-key", "threshold": 5, "recurrence": 0}
+      ]
+    },
+    {
+      "id": 777002, "friendly_name": "Shop DB", "url": "db.example.com", "type": 4,
+      "sub_type": 99, "port": 5432, "interval": 60, "status": 0
+    },
+    {
+      "id": 777003, "friendly_name": "Nightly Backup", "url": "", "type": 5, "interval": 86400, "status": 2
+    }
+  ],
+  "alert_contacts": [
+    {"id": "501", "friendly_name": "Ops Email", "type": "2", "value": "ops@example.com"},
+    {"id": "502", "friendly_name": "Ops PagerDuty", "type": "16", "value": "pd-secret-key"},
+    {"id": "503", "friendly_name": "Ops Telegram", "type": "18", "value": "12345"}
+  ]
+}`
+
+const betterStackFixture = `{
+  "data": [
+    {
+      "id": "1001", "type": "monitor",
+      "attributes": {
+        "pronounceable_name": "Status API", "url": "https://api.example.com/status",
+        "monitor_type": "expected_status_code", "expected_status_codes": [200, 201],
+        "check_frequency": 60, "request_timeout": 15, "http_method": "post",
+        "request_body": "{\"ping\":true}", "follow_redirects": false, "ssl_expiration": null,
+        "regions": ["us", "eu"], "confirmation_period": 30, "email": true, "sms": true
+      }
+    },
+    {
+      "id": "1002", "type": "monitor",
+      "attributes": {
+        "pronounceable_name": "Mail", "url": "mail.example.com", "monitor_type": "smtp",
+        "check_frequency": 180, "paused": true
+      }
+    },
+    {
+      "id": "1003", "type": "monitor",
+      "attributes": {
+        "pronounceable_name": "Resolver", "url": "example.com", "monitor_type": "dns"
+      }
+    }
+  ]
+}`
+
+// migrate runs the migrate subcommand on an export and returns the output
+// directory
+func migrate(t *testing.T, export string, args ...string) (string, *bytes.Buffer) {
+	t.Helper()
+
+	in := filepath.Join(t.TempDir(), "export.json")
+	require.NoError(t, os.WriteFile(in, []byte(export), 0o644))
+	out := filepath.Join(t.TempDir(), "migrated")
+
+	var stdout, stderr bytes.Buffer
+	code := Run(context.Background(), append([]string{"migrate", "-in", in, "-out", out}, args...), &stdout, &stderr)
+	require.Equal(t, exitOK, code, stderr.String())
+	return out, &stdout
+}
+
+func readFile(t *testing.T, dir, name string) string {
+	t.Helper()
+
+	content, err := os.ReadFile(filepath.Join(dir, name))
+	require.NoError(t, err)
+	return string(content)
+}
+
+func TestMigrate_UptimeRobot(t *testing.T) {
+	out, stdout := migrate(t, uptimeRobotFixture)
+
+	assert.Contains(t, stdout.String(), "Converted 2 monitors and 2 contacts")
+	assert.Contains(t, stdout.String(), "Set the 1 secret variables declared in variables.tf")
+
+	assert.Equal(t, `resource "uptime_contact" "ops_email" {
+  name    = "Ops Email"
+  channel = "email"
+
+  email_settings = {
+    email = "ops@example.com"
+  }
+}
+
+resource "uptime_contact" "ops_pagerduty" {
+  name    = "Ops PagerDuty"
+  channel = "pagerduty"
+
+  pagerduty_settings = {
+    integration_key = var.contact_ops_pagerduty_integration_key
+  }
+}
+`, readFile(t, out, "contacts.tf"))
+
+	assert.Equal(t, `resource "uptime_monitor" "shop_home" {
+  name           = "Shop Home"
+  url            = "https://shop.example.com"
+  type           = "https"
+  check_interval = 300
+  timeout        = 30
+  contacts       = [uptime_contact.ops_email.id, uptime_contact.ops_pagerduty.id]
+
+  https_settings = {
+    expected_response_body = "Welcome"
+    method                 = "GET"
+    expected_status_codes  = "200,204"
+
+    request_headers = {
+      "X-Check" = "uptime"
+    }
+  }
+}
+
+resource "uptime_monitor" "shop_db" {
+  name           = "Shop DB"
+  url            = "tcp://db.example.com:5432"
+  type           = "tcp"
+  check_interval = 60
+  active         = false
+  tcp_settings   = {}
+}
+`, readFile(t, out, "monitors.tf"))
+
+	report := readFile(t, out, migrationReportFile)
+	assert.Contains(t, report, "keyword_case_type: case-insensitive keyword matching is not supported")
+	assert.Contains(t, report, "custom_http_statuses: status codes marked as down (503)")
+	assert.Contains(t, report, "the threshold and recurrence of alert contact 502 are not supported")
+	assert.Contains(t, report, `monitor "Nightly Backup" (id 777003)`)
+	assert.Contains(t, report, `contact "Ops Telegram" (id 503)`)
+
+	for _, name := range []string{"contacts.tf", "monitors.tf", "variables.tf", migrationReportFile} {
+		assert.NotContains(t, readFile(t, out, name), "pd-secret-key")
+	}
+}
+
+func TestMigrate_BetterStack(t *testing.T) {
+	out, stdout := migrate(t, betterStackFixture)
+
+	assert.Contains(t, stdout.String(), "Converted 2 monitors and 0 contacts")
+	assert.NoFileExists(t, filepath.Join(out, "contacts.tf"))
+	assert.NoFileExists(t, filepath.Join(out, "variables.tf"))
+
+	assert.Equal(t, `resource "uptime_monitor" "status_api" {
+  name           = "Status API"
+  url            = "https://api.example.com/status"
+  type           = "https"
+  check_interval = 60
+  timeout        = 15
+
+  https_settings = {
+    expected_status_codes        = "200,201"
+    method                       = "POST"
+    request_body                 = "{\"ping\":true}"
+    follow_redirects             = false
+    check_certificate_expiration = false
+  }
+}
+
+resource "uptime_monitor" "mail" {
+  name           = "Mail"
+  url            = "tcp://mail.example.com:25"
+  type           = "tcp"
+  check_interval = 180
+  active         = false
+  tcp_settings   = {}
+}
+`, readFile(t, out, "monitors.tf"))
+
+	report := readFile(t, out, migrationReportFile)
+	assert.Contains(t, report, "Migration of the Better Stack export")
+	assert.Contains(t, report, "regions: regions us, eu have no direct equivalent")
+	assert.Contains(t, report, "alerts: Better Stack notified team members by email, sms")
+	assert.Contains(t, report, "SMTP checks become TCP checks that only test whether port 25 accepts connections")
+	assert.Contains(t, report, `"dns" monitors are not supported; the monitor was skipped`)
+}
+
+func TestDetectExportFormat(t *testing.T) {
+	format, err := detectExportFormat([]byte(uptimeRobotFixture))
+	require.NoError(t, err)
+	assert.Equal(t, formatUptimeRobot, format)
+
+	format, err = detectExportFormat([]byte(betterStackFixture))
+	require.NoError(t, err)
+	assert.Equal(t, formatBetterStack, format)
+
+	_, err = detectExportFormat([]byte(`{"checks": []}`))
+	assert.ErrorContains(t, err, "unrecognized export")
+
+	_, err = detectExportFormat([]byte(`[]`))
+	assert.ErrorContains(t, err, "not a JSON object")
+}
+
+func TestMigrate_RequiresInAndOut(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	assert.Equal(t, exitUsage, Run(context.Background(), []string{"migrate", "-out", t.TempDir()}, &stdout, &stderr))
+	assert.Contains(t, stderr.String(), "Usage: terraform-provider-uptime migrate")
+}
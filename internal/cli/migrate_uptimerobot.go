@@ -0,0 +1,288 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// UptimeRobot monitor types
+const (
+	uptimeRobotHTTP      = 1
+	uptimeRobotKeyword   = 2
+	uptimeRobotPing      = 3
+	uptimeRobotPort      = 4
+	uptimeRobotHeartbeat = 5
+)
+
+// uptimeRobotKeywordExists alerts when the keyword is found; the other
+// keyword type alerts when it is missing
+const uptimeRobotKeywordExists = 1
+
+// uptimeRobotPorts maps the sub_type of port monitors to their port.
+// Sub type 99 is a custom port, given in the port field.
+var uptimeRobotPorts = map[int]int{1: 80, 2: 443, 3: 21, 4: 25, 5: 110, 6: 143}
+
+// uptimeRobotMethods maps http_method codes to HTTP methods
+var uptimeRobotMethods = map[int]string{1: "HEAD", 2: "GET", 3: "POST", 4: "PUT", 5: "PATCH", 6: "DELETE", 7: "OPTIONS"}
+
+// uptimeRobotChannels maps alert contact types to contact channels and the
+// settings attribute that receives the contact's value
+var uptimeRobotChannels = map[int]struct {
+	channel string
+	setting string
+	secret  bool
+}{
+	1:  {"sms", "phone", false},
+	2:  {"email", "email", false},
+	5:  {"webhook", "url", false},
+	8:  {"sms", "phone", false},
+	11: {"slack", "webhook_url", false},
+	16: {"pagerduty", "integration_key", true},
+	17: {"opsgenie", "api_key", true},
+	23: {"discord", "webhook_url", false},
+}
+
+// uptimeRobotExport is the response of getMonitors, optionally with the
+// alert_contacts array of getAlertContacts merged in
+type uptimeRobotExport struct {
+	Monitors      []uptimeRobotMonitor      `json:"monitors"`
+	AlertContacts []uptimeRobotAlertContact `json:"alert_contacts"`
+}
+
+type uptimeRobotMonitor struct {
+	ID                 flexString                `json:"id"`
+	FriendlyName       string                    `json:"friendly_name"`
+	URL                string                    `json:"url"`
+	Type               int                       `json:"type"`
+	SubType            flexString                `json:"sub_type"`
+	KeywordType        flexString                `json:"keyword_type"`
+	KeywordValue       string                    `json:"keyword_value"`
+	KeywordCaseType    flexString                `json:"keyword_case_type"`
+	HTTPUsername       string                    `json:"http_username"`
+	HTTPMethod         flexString                `json:"http_method"`
+	PostValue          json.RawMessage           `json:"post_value"`
+	Port               flexString                `json:"port"`
+	Interval           int                       `json:"interval"`
+	Timeout            int                       `json:"timeout"`
+	Status             int                       `json:"status"`
+	CustomHTTPHeaders  map[string]string         `json:"custom_http_headers"`
+	CustomHTTPStatuses string                    `json:"custom_http_statuses"`
+	AlertContacts      []uptimeRobotAlertContact `json:"alert_contacts"`
+}
+
+type uptimeRobotAlertContact struct {
+	ID           flexString `json:"id"`
+	FriendlyName string     `json:"friendly_name"`
+	Type         flexString `json:"type"`
+	Value        string     `json:"value"`
+	Threshold    flexString `json:"threshold"`
+	Recurrence   flexString `json:"recurrence"`
+}
+
+// fromUptimeRobot converts an UptimeRobot export
+func (m *migration) fromUptimeRobot(data []byte) error {
+	var export uptimeRobotExport
+	if err := json.Unmarshal(data, &export); err != nil {
+		return fmt.Errorf("unable to parse UptimeRobot export: %w", err)
+	}
+
+	// Contacts are listed on their own by getAlertContacts, and on each
+	// monitor by getMonitors
+	contacts := export.AlertContacts
+	for _, monitor := range export.Monitors {
+		contacts = append(contacts, monitor.AlertContacts...)
+	}
+	for _, contact := range contacts {
+		if _, ok := m.contactReferences[string(contact.ID)]; ok || contact.Type == "" {
+			continue
+		}
+		m.uptimeRobotContact(contact)
+	}
+
+	for _, monitor := range export.Monitors {
+		m.uptimeRobotMonitor(monitor)
+	}
+
+	return nil
+}
+
+// uptimeRobotContact converts an alert contact, recording a note if its
+// type has no equivalent channel
+func (m *migration) uptimeRobotContact(contact uptimeRobotAlertContact) {
+	name := contact.FriendlyName
+	if name == "" {
+		name = contact.Value
+	}
+	object := fmt.Sprintf("contact %q (id %s)", name, contact.ID)
+
+	channel, ok := uptimeRobotChannels[contact.Type.int()]
+	if !ok {
+		m.note(object, "type", fmt.Sprintf("alert contact type %s has no equivalent channel; the contact was skipped", contact.Type))
+		// Mark the contact as seen so monitors using it can report it
+		m.contactReferences[string(contact.ID)] = ""
+		return
+	}
+
+	settings := map[string]string{channel.setting: contact.Value}
+	if channel.secret {
+		m.addContact(string(contact.ID), name, channel.channel, settings, channel.setting)
+	} else {
+		m.addContact(string(contact.ID), name, channel.channel, settings)
+	}
+}
+
+// uptimeRobotMonitor converts a monitor, recording notes for the settings
+// that cannot be translated
+func (m *migration) uptimeRobotMonitor(source uptimeRobotMonitor) {
+	object := fmt.Sprintf("monitor %q (id %s)", source.FriendlyName, source.ID)
+	monitor := migratedMonitor{
+		name:          source.FriendlyName,
+		url:           source.URL,
+		checkInterval: source.Interval,
+		timeout:       source.Timeout,
+		paused:        source.Status == 0,
+	}
+
+	switch source.Type {
+	case uptimeRobotHTTP, uptimeRobotKeyword:
+		monitor.monitorType = "https"
+		monitor.https = m.uptimeRobotHTTPSettings(object, source)
+
+	case uptimeRobotPing:
+		monitor.monitorType = "ping"
+		monitor.url = hostOf(source.URL)
+
+	case uptimeRobotPort:
+		port := source.Port.int()
+		if source.SubType.int() != 99 {
+			port = uptimeRobotPorts[source.SubType.int()]
+		}
+		if port == 0 {
+			m.note(object, "port", fmt.Sprintf("port monitor sub type %q has no known port; the monitor was skipped", source.SubType))
+			return
+		}
+		monitor.monitorType = "tcp"
+		monitor.url = "tcp://" + net.JoinHostPort(hostOf(source.URL), strconv.Itoa(port))
+
+	case uptimeRobotHeartbeat:
+		m.note(object, "type", "heartbeat (cron job) monitors are not supported; the monitor was skipped")
+		return
+
+	default:
+		m.note(object, "type", fmt.Sprintf("monitor type %d is not supported; the monitor was skipped", source.Type))
+		return
+	}
+
+	for _, contact := range source.AlertContacts {
+		reference := m.contactReferences[string(contact.ID)]
+		if reference == "" {
+			m.note(object, "alert_contacts", fmt.Sprintf("alert contact %s was not converted and is not attached", contact.ID))
+			continue
+		}
+		monitor.contacts = append(monitor.contacts, reference)
+
+		if contact.Threshold.int() > 0 || contact.Recurrence.int() > 0 {
+			m.note(object, "alert_contacts", fmt.Sprintf("the threshold and recurrence of alert contact %s are not supported; alerts are sent on every status change", contact.ID))
+		}
+	}
+
+	m.addMonitor(monitor)
+}
+
+// uptimeRobotHTTPSettings converts the settings of HTTP and keyword monitors
+func (m *migration) uptimeRobotHTTPSettings(object string, source uptimeRobotMonitor) *hclBody {
+	settings := &hclBody{}
+
+	method := uptimeRobotMethods[source.HTTPMethod.int()]
+	if source.Type == uptimeRobotKeyword && source.KeywordValue != "" {
+		if source.KeywordType.int() == uptimeRobotKeywordExists {
+			m.note(object, "keyword", fmt.Sprintf("alerting when %q is present is not supported, only when an expected text is missing", source.KeywordValue))
+		} else {
+			// The response body is needed to look for the keyword
+			if method == "" || method == "HEAD" {
+				method = "GET"
+			}
+			settings.set("expected_response_body", hclRaw(hclString(source.KeywordValue)))
+			if source.KeywordCaseType.int() == 1 {
+				m.note(object, "keyword_case_type", "case-insensitive keyword matching is not supported; the keyword is matched exactly")
+			}
+		}
+	}
+	if method != "" && method != "HEAD" {
+		settings.set("method", hclRaw(hclString(method)))
+	}
+
+	if codes, down := uptimeRobotStatusCodes(source.CustomHTTPStatuses); codes != "" || len(down) > 0 {
+		if codes != "" {
+			settings.set("expected_status_codes", hclRaw(hclString(codes)))
+		}
+		if len(down) > 0 {
+			m.note(object, "custom_http_statuses", fmt.Sprintf("status codes marked as down (%s) cannot be listed; only expected codes are supported", strings.Join(down, ", ")))
+		}
+	}
+
+	if len(source.CustomHTTPHeaders) > 0 {
+		headers := &hclBody{}
+		for _, name := range sortedKeys(source.CustomHTTPHeaders) {
+			headers.set(hclString(name), hclRaw(hclString(source.CustomHTTPHeaders[name])))
+		}
+		settings.set("request_headers", headers)
+	}
+
+	if body := postValue(source.PostValue); body != "" {
+		settings.set("request_body", hclRaw(hclString(body)))
+	}
+
+	if source.HTTPUsername != "" {
+		m.note(object, "http_username", "HTTP authentication is not supported; add an Authorization header to request_headers, using a variable for the credentials")
+	}
+
+	return settings
+}
+
+// uptimeRobotStatusCodes splits custom_http_statuses, e.g. "404:0_200:1",
+// into the codes treated as up, joined by commas, and the codes treated
+// as down
+func uptimeRobotStatusCodes(statuses string) (string, []string) {
+	var up, down []string
+	for _, entry := range strings.Split(statuses, "_") {
+		code, state, ok := strings.Cut(strings.TrimSpace(entry), ":")
+		if !ok || code == "" {
+			continue
+		}
+		if state == "1" {
+			up = append(up, code)
+		} else {
+			down = append(down, code)
+		}
+	}
+	sort.Strings(up)
+	sort.Strings(down)
+	return strings.Join(up, ","), down
+}
+
+// postValue returns the request body of a monitor, which UptimeRobot
+// stores as a string or as a JSON object
+func postValue(raw json.RawMessage) string {
+	if len(raw) == 0 || string(raw) == "null" {
+		return ""
+	}
+	var text string
+	if err := json.Unmarshal(raw, &text); err == nil {
+		return text
+	}
+	return string(raw)
+}
+
+// hostOf returns the host of a URL, or the text itself if it has no scheme
+func hostOf(text string) string {
+	if u, err := url.Parse(text); err == nil && u.Hostname() != "" {
+		return u.Hostname()
+	}
+	return text
+}
@@ -0,0 +1,121 @@
+package client
+
+import "sync"
+
+// cache holds lookups that only need to be fetched once per provider run.
+// A new Client is created every time the provider is configured, so cached
+// values never outlive a single plan or apply.
+type cache struct {
+	mu      sync.Mutex
+	regions []Region
+	account *Account
+
+	// contacts and monitors are dropped whenever one is created or deleted,
+	// so references to objects created earlier in the run resolve
+	contacts []Contact
+	monitors []Monitor
+
+	// plannedMonitors counts monitor creations planned during this run
+	plannedMonitors int
+}
+
+// ListRegionsCached returns the available check regions, fetching them from
+// the API on first use
+func (c *Client) ListRegionsCached() ([]Region, error) {
+	c.cache.mu.Lock()
+	defer c.cache.mu.Unlock()
+
+	if c.cache.regions != nil {
+		return c.cache.regions, nil
+	}
+
+	regions, err := c.ListRegions()
+	if err != nil {
+		return nil, err
+	}
+
+	c.cache.regions = regions
+	return regions, nil
+}
+
+// GetAccountCached returns the account, fetching it from the API on first use
+func (c *Client) GetAccountCached() (*Account, error) {
+	c.cache.mu.Lock()
+	defer c.cache.mu.Unlock()
+
+	if c.cache.account != nil {
+		return c.cache.account, nil
+	}
+
+	account, err := c.GetAccount()
+	if err != nil {
+		return nil, err
+	}
+
+	c.cache.account = account
+	return account, nil
+}
+
+// ListContactsCached returns every contact in the account, including those
+// of other environments, fetching them from the API on first use
+func (c *Client) ListContactsCached() ([]Contact, error) {
+	c.cache.mu.Lock()
+	defer c.cache.mu.Unlock()
+
+	if c.cache.contacts != nil {
+		return c.cache.contacts, nil
+	}
+
+	contacts, err := c.listAllContacts()
+	if err != nil {
+		return nil, err
+	}
+
+	c.cache.contacts = append([]Contact{}, contacts...)
+	return c.cache.contacts, nil
+}
+
+// ListMonitorsCached returns every monitor in the account, including those
+// of other environments, fetching them from the API on first use
+func (c *Client) ListMonitorsCached() ([]Monitor, error) {
+	c.cache.mu.Lock()
+	defer c.cache.mu.Unlock()
+
+	if c.cache.monitors != nil {
+		return c.cache.monitors, nil
+	}
+
+	monitors, err := c.listAllMonitors()
+	if err != nil {
+		return nil, err
+	}
+
+	c.cache.monitors = append([]Monitor{}, monitors...)
+	return c.cache.monitors, nil
+}
+
+// forgetContacts drops the cached contacts after one was created or deleted
+func (c *Client) forgetContacts() {
+	c.cache.mu.Lock()
+	defer c.cache.mu.Unlock()
+
+	c.cache.contacts = nil
+}
+
+// forgetMonitors drops the cached monitors after one was created or deleted
+func (c *Client) forgetMonitors() {
+	c.cache.mu.Lock()
+	defer c.cache.mu.Unlock()
+
+	c.cache.monitors = nil
+}
+
+// PlanMonitorCreation records a planned monitor creation and returns the
+// number of creations planned so far during this run, including this one
+func (c *Client) PlanMonitorCreation() int {
+	c.cache.mu.Lock()
+	defer c.cache.mu.Unlock()
+
+	c.cache.plannedMonitors++
+	return c.cache.plannedMonitors
+}
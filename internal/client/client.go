@@ -9,11 +9,46 @@ import (
 	"time"
 )
 
+// listPageSize is the number of items requested per page from paginated
+// list endpoints
+const listPageSize = 100
+
 // Client represents the API client for the Uptime Monitor service
 type Client struct {
 	BaseURL    string
 	APIKey     string
 	HTTPClient *http.Client
+
+	// EnforceQuota turns plan-time quota warnings into errors
+	EnforceQuota bool
+
+	// MonitorDefaults holds values applied to monitors that leave them unset
+	MonitorDefaults MonitorDefaults
+
+	// NamePrefix and NameSuffix are added to monitor, contact and status
+	// page names on write and removed again on read
+	NamePrefix string
+	NameSuffix string
+
+	// ReadOnly makes the client refuse every request other than GET
+	ReadOnly bool
+
+	keys  keySource
+	cache cache
+}
+
+// MonitorDefaults holds provider-wide monitor settings. Nil slices and zero
+// values mean no default is configured.
+type MonitorDefaults struct {
+	Regions       []string
+	Contacts      []string
+	CheckInterval int
+	Timeout       int
+	FailThreshold int
+
+	// Unknown is set when the provider's monitor_defaults are not known
+	// yet, so the attributes they fill must be left unknown in plans
+	Unknown bool
 }
 
 // NewClient creates a new API client
@@ -27,15 +62,52 @@ func NewClient(baseURL, apiKey string) *Client {
 	}
 }
 
-// doRequest performs an HTTP request with authentication
+// doRequest performs an HTTP request with authentication. If the API
+// rejects the key and a fresh one can be obtained from the client's
+// APIKeyFunc, the request is retried once with the new key.
 func (c *Client) doRequest(method, path string, body interface{}) (*http.Response, error) {
-	var bodyReader io.Reader
+	if c.ReadOnly && method != http.MethodGet {
+		return nil, fmt.Errorf("refusing %s %s: %w", method, path, ErrReadOnly)
+	}
+
+	var jsonBody []byte
 	if body != nil {
-		jsonBody, err := json.Marshal(body)
+		var err error
+		jsonBody, err = json.Marshal(body)
 		if err != nil {
 			return nil, fmt.Errorf("failed to marshal request body: %w", err)
 		}
-		bodyReader = bytes.NewBuffer(jsonBody)
+	}
+
+	apiKey, err := c.currentAPIKey()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.send(method, path, jsonBody, apiKey)
+	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+		return resp, err
+	}
+
+	newKey, err := c.refreshAPIKey(apiKey)
+	if err != nil {
+		_ = resp.Body.Close()
+		return nil, err
+	}
+	if newKey == "" {
+		// No new key is available, so report the original 401 response
+		return resp, nil
+	}
+	_ = resp.Body.Close()
+
+	return c.send(method, path, jsonBody, newKey)
+}
+
+// send performs a single HTTP request with the given API key
+func (c *Client) send(method, path string, jsonBody []byte, apiKey string) (*http.Response, error) {
+	var bodyReader io.Reader
+	if jsonBody != nil {
+		bodyReader = bytes.NewReader(jsonBody)
 	}
 
 	req, err := http.NewRequest(method, c.BaseURL+path, bodyReader)
@@ -44,7 +116,7 @@ func (c *Client) doRequest(method, path string, body interface{}) (*http.Respons
 	}
 
 	// Add authentication header
-	req.Header.Set("Authorization", "Bearer "+c.APIKey)
+	req.Header.Set("Authorization", "Bearer "+apiKey)
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Accept", "application/json")
 
@@ -65,8 +137,8 @@ func (c *Client) checkResponse(resp *http.Response) error {
 	defer func() { _ = resp.Body.Close() }()
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return fmt.Errorf("HTTP %d: failed to read error response", resp.StatusCode)
+		return &APIError{StatusCode: resp.StatusCode, Body: "failed to read error response"}
 	}
 
-	return fmt.Errorf("HTTP %d: %s", resp.StatusCode, string(body))
+	return &APIError{StatusCode: resp.StatusCode, Body: string(body)}
 }
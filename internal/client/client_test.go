@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -365,6 +366,15 @@ func TestClient_GetAccount(t *testing.T) {
 				UpMonitors:     20,
 				DownMonitors:   3,
 				PausedMonitors: 2,
+
+				MinCheckInterval:     30,
+				AllowedRegions:       []string{"us-east-1", "eu-west-1"},
+				MaxRegionsPerMonitor: 3,
+				SMSCreditsRemaining:  50,
+				StatusPagesLimit:     5,
+				ContactsLimit:        20,
+				EnabledChannels:      []string{"email", "slack"},
+				EnabledMonitorTypes:  []string{"https", "tcp", "ping"},
 			},
 		}
 
@@ -392,6 +402,14 @@ func TestClient_GetAccount(t *testing.T) {
 	assert.Equal(t, 20, account.UpMonitors)
 	assert.Equal(t, 3, account.DownMonitors)
 	assert.Equal(t, 2, account.PausedMonitors)
+	assert.Equal(t, 30, account.MinCheckInterval)
+	assert.Equal(t, []string{"us-east-1", "eu-west-1"}, account.AllowedRegions)
+	assert.Equal(t, 3, account.MaxRegionsPerMonitor)
+	assert.Equal(t, 50, account.SMSCreditsRemaining)
+	assert.Equal(t, 5, account.StatusPagesLimit)
+	assert.Equal(t, 20, account.ContactsLimit)
+	assert.Equal(t, []string{"email", "slack"}, account.EnabledChannels)
+	assert.Equal(t, []string{"https", "tcp", "ping"}, account.EnabledMonitorTypes)
 }
 
 func TestClient_GetAccount_Error(t *testing.T) {
@@ -420,9 +438,553 @@ func TestClient_GetAccount_Error(t *testing.T) {
 	assert.Error(t, err)
 	assert.Nil(t, account)
 	assert.Contains(t, err.Error(), "HTTP 401")
+
+	var apiErr *APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, 401, apiErr.StatusCode)
+	assert.Contains(t, apiErr.Body, "Invalid API key")
+}
+
+func TestClient_ListStatusPages_Pagination(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/api/status_pages", r.URL.Path)
+		assert.Equal(t, "100", r.URL.Query().Get("per_page"))
+
+		var resp ListStatusPagesResponse
+		switch r.URL.Query().Get("page") {
+		case "1":
+			resp = ListStatusPagesResponse{
+				Status: "ok",
+				Data: &ListStatusPagesData{
+					StatusPages: []StatusPage{{ID: "sp1", Name: "Public"}},
+					Pagination:  &Pagination{Page: 1, PerPage: 100, Total: 2, TotalPages: 2, HasNext: true},
+				},
+			}
+		case "2":
+			resp = ListStatusPagesResponse{
+				Status: "ok",
+				Data: &ListStatusPagesData{
+					StatusPages: []StatusPage{{ID: "sp2", Name: "Internal", CustomDomain: stringPtr("status.example.com")}},
+					Pagination:  &Pagination{Page: 2, PerPage: 100, Total: 2, TotalPages: 2, HasPrev: true},
+				},
+			}
+		default:
+			t.Errorf("unexpected page requested: %s", r.URL.Query().Get("page"))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(resp); err != nil {
+			t.Errorf("Failed to encode response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+
+	statusPages, err := client.ListStatusPages()
+
+	require.NoError(t, err)
+	require.Len(t, statusPages, 2)
+	assert.Equal(t, "sp1", statusPages[0].ID)
+	assert.Equal(t, "sp2", statusPages[1].ID)
+	assert.Equal(t, "status.example.com", *statusPages[1].CustomDomain)
+}
+
+func TestClient_ListRegions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/api/regions", r.URL.Path)
+		assert.Equal(t, "Bearer test-api-key", r.Header.Get("Authorization"))
+
+		resp := ListRegionsResponse{
+			Status: "ok",
+			Data: &ListRegionsData{
+				Regions: []Region{
+					{ID: "us-east-1", Name: "US East (Virginia)", Continent: "North America", IPv4: true, IPv6: true, Available: true},
+					{ID: "ap-south-1", Name: "Asia Pacific (Mumbai)", Continent: "Asia", IPv4: true, Available: false},
+				},
+			},
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(resp); err != nil {
+			t.Errorf("Failed to encode response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+
+	regions, err := client.ListRegions()
+
+	require.NoError(t, err)
+	require.Len(t, regions, 2)
+	assert.Equal(t, "us-east-1", regions[0].ID)
+	assert.True(t, regions[0].IPv6)
+	assert.True(t, regions[0].Available)
+	assert.Equal(t, "Asia", regions[1].Continent)
+	assert.False(t, regions[1].IPv6)
+	assert.False(t, regions[1].Available)
+}
+
+func TestClient_GetMonitorStats(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/api/monitors/mon-123/stats", r.URL.Path)
+		assert.Equal(t, "7d", r.URL.Query().Get("window"))
+
+		resp := MonitorStatsResponse{
+			Status: "ok",
+			Data: &MonitorStatsData{
+				Stats: &MonitorStats{
+					MonitorID:        "mon-123",
+					Window:           "7d",
+					UptimePercentage: 99.95,
+					AvgResponseTime:  182.4,
+					P95ResponseTime:  410,
+					IncidentCount:    1,
+					TotalChecks:      20160,
+					FailedChecks:     10,
+					Regions: []RegionStats{
+						{Region: "us-east-1", UptimePercentage: 99.9, TotalChecks: 10080, FailedChecks: 10},
+						{Region: "eu-west-1", UptimePercentage: 100, TotalChecks: 10080},
+					},
+				},
+			},
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(resp); err != nil {
+			t.Errorf("Failed to encode response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+
+	stats, err := client.GetMonitorStats("mon-123", "7d")
+
+	require.NoError(t, err)
+	assert.Equal(t, 99.95, stats.UptimePercentage)
+	assert.Equal(t, 410.0, stats.P95ResponseTime)
+	assert.Equal(t, 1, stats.IncidentCount)
+	require.Len(t, stats.Regions, 2)
+	assert.Equal(t, "eu-west-1", stats.Regions[1].Region)
+}
+
+func TestClient_ListMonitorCheckResults(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/api/monitors/mon-123/checks", r.URL.Path)
+		assert.Equal(t, "5", r.URL.Query().Get("limit"))
+		assert.Empty(t, r.URL.Query().Get("from"))
+
+		resp := ListCheckResultsResponse{
+			Status: "ok",
+			Data: &ListCheckResultsData{
+				Checks: []CheckResult{
+					{CheckedAt: 1700000060, Region: "us-east-1", Status: "up", ResponseTime: 120, StatusCode: 200},
+					{CheckedAt: 1700000000, Region: "eu-west-1", Status: "down", ResponseTime: 30000, Error: stringPtr("timeout")},
+				},
+			},
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(resp); err != nil {
+			t.Errorf("Failed to encode response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+
+	checks, err := client.ListMonitorCheckResults("mon-123", CheckResultsOptions{Limit: 5})
+
+	require.NoError(t, err)
+	require.Len(t, checks, 2)
+	assert.Equal(t, 200, checks[0].StatusCode)
+	assert.Equal(t, "down", checks[1].Status)
+	require.NotNil(t, checks[1].Error)
+	assert.Equal(t, "timeout", *checks[1].Error)
+}
+
+func TestClient_ListIncidents_Pagination(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/api/incidents", r.URL.Path)
+		assert.Equal(t, "mon-1,mon-2", r.URL.Query().Get("monitor_ids"))
+		assert.Equal(t, "1704067200", r.URL.Query().Get("from"))
+		assert.Empty(t, r.URL.Query().Get("to"))
+		assert.Equal(t, "resolved", r.URL.Query().Get("status"))
+
+		var resp ListIncidentsResponse
+		switch r.URL.Query().Get("page") {
+		case "1":
+			resp = ListIncidentsResponse{
+				Status: "ok",
+				Data: &ListIncidentsData{
+					Incidents:  []Incident{{ID: "inc1", MonitorID: "mon-1", Status: "resolved", StartedAt: 1704100000, ResolvedAt: 1704100300, Duration: 300}},
+					Pagination: &Pagination{Page: 1, PerPage: 100, Total: 2, TotalPages: 2, HasNext: true},
+				},
+			}
+		case "2":
+			resp = ListIncidentsResponse{
+				Status: "ok",
+				Data: &ListIncidentsData{
+					Incidents:  []Incident{{ID: "inc2", MonitorID: "mon-2", Status: "resolved", Cause: "HTTP 503", Regions: []string{"us-east-1"}}},
+					Pagination: &Pagination{Page: 2, PerPage: 100, Total: 2, TotalPages: 2, HasPrev: true},
+				},
+			}
+		default:
+			t.Errorf("unexpected page requested: %s", r.URL.Query().Get("page"))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(resp); err != nil {
+			t.Errorf("Failed to encode response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+
+	incidents, err := client.ListIncidents(ListIncidentsOptions{
+		MonitorIDs: []string{"mon-1", "mon-2"},
+		From:       1704067200,
+		Status:     "resolved",
+	})
+
+	require.NoError(t, err)
+	require.Len(t, incidents, 2)
+	assert.Equal(t, int64(300), incidents[0].Duration)
+	assert.Equal(t, "HTTP 503", incidents[1].Cause)
+	assert.Equal(t, []string{"us-east-1"}, incidents[1].Regions)
+}
+
+func TestClient_NamePrefixAndSuffix(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var resp MonitorResponse
+		switch r.Method {
+		case "POST":
+			var req CreateMonitorRequest
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			assert.Equal(t, "[staging] Checkout API (eu)", req.Name)
+			resp = MonitorResponse{Status: "ok", Data: &MonitorData{Monitor: &Monitor{ID: "mon-1", Name: req.Name}}}
+		case "PUT":
+			var req UpdateMonitorRequest
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			require.NotNil(t, req.Name)
+			assert.Equal(t, "[staging] Checkout (eu)", *req.Name)
+			resp = MonitorResponse{Status: "ok", Data: &MonitorData{Monitor: &Monitor{ID: "mon-1", Name: *req.Name}}}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(resp); err != nil {
+			t.Errorf("Failed to encode response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+	client.NamePrefix = "[staging] "
+	client.NameSuffix = " (eu)"
+
+	monitor, err := client.CreateMonitor(CreateMonitorRequest{Name: "Checkout API"})
+	require.NoError(t, err)
+	assert.Equal(t, "Checkout API", monitor.Name)
+
+	monitor, err = client.UpdateMonitor("mon-1", UpdateMonitorRequest{Name: stringPtr("Checkout")})
+	require.NoError(t, err)
+	assert.Equal(t, "Checkout", monitor.Name)
+
+	// Names created outside this environment are left untouched
+	name, ok := client.unqualifyName("[prod] Checkout API")
+	assert.Equal(t, "[prod] Checkout API", name)
+	assert.False(t, ok)
+	name, ok = client.unqualifyName("[staging] Checkout API")
+	assert.Equal(t, "[staging] Checkout API", name)
+	assert.False(t, ok)
+}
+
+func TestClient_NamePrefix_ListsSkipOtherEnvironments(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/api/monitors":
+			_ = json.NewEncoder(w).Encode(ListMonitorsResponse{
+				Status: "ok",
+				Data: &ListMonitorsData{Monitors: []Monitor{
+					{ID: "prod-monitor", Name: "Checkout API", Contacts: []string{"shared-contact"}},
+					{ID: "staging-monitor", Name: "[staging] Checkout API", Contacts: []string{"shared-contact"}},
+				}},
+			})
+		case "/api/contacts":
+			_ = json.NewEncoder(w).Encode(ListContactsResponse{
+				Status: "ok",
+				Data: &ListContactsData{Contacts: []Contact{
+					{ID: "prod-contact", Name: "Ops"},
+					{ID: "staging-contact", Name: "[staging] Ops"},
+				}},
+			})
+		case "/api/status_pages":
+			_ = json.NewEncoder(w).Encode(ListStatusPagesResponse{
+				Status: "ok",
+				Data: &ListStatusPagesData{StatusPages: []StatusPage{
+					{ID: "staging-page", Name: "[staging] Shop", Monitors: []string{"prod-monitor"}},
+					{ID: "prod-page", Name: "Shop", Monitors: []string{"prod-monitor"}},
+				}},
+			})
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+	client.NamePrefix = "[staging] "
+
+	monitors, err := client.ListMonitors()
+	require.NoError(t, err)
+	require.Len(t, monitors, 1)
+	assert.Equal(t, "staging-monitor", monitors[0].ID)
+	assert.Equal(t, "Checkout API", monitors[0].Name)
+
+	contacts, err := client.ListContacts()
+	require.NoError(t, err)
+	require.Len(t, contacts, 1)
+	assert.Equal(t, "staging-contact", contacts[0].ID)
+	assert.Equal(t, "Ops", contacts[0].Name)
+
+	statusPages, err := client.ListStatusPages()
+	require.NoError(t, err)
+	require.Len(t, statusPages, 1)
+	assert.Equal(t, "staging-page", statusPages[0].ID)
+	assert.Equal(t, "Shop", statusPages[0].Name)
+
+	// Objects of other environments still count when checking usage
+	using, err := client.MonitorsUsingContact("shared-contact")
+	require.NoError(t, err)
+	assert.Len(t, using, 2)
+
+	showing, err := client.StatusPagesShowingMonitor("prod-monitor")
+	require.NoError(t, err)
+	assert.Len(t, showing, 2)
 }
 
 // Helper function
 func stringPtr(s string) *string {
 	return &s
 }
+
+// accountServer returns a server that accepts only the given API key
+func accountServer(t *testing.T, validKey string, requests *int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*requests++
+
+		w.Header().Set("Content-Type", "application/json")
+		if r.Header.Get("Authorization") != "Bearer "+validKey {
+			w.WriteHeader(401)
+			_ = json.NewEncoder(w).Encode(AccountResponse{Status: "error", Error: stringPtr("Invalid API key")})
+			return
+		}
+
+		_ = json.NewEncoder(w).Encode(AccountResponse{Status: "ok", Data: &Account{ID: "account123"}})
+	}))
+}
+
+func TestClient_APIKeyFunc_RefreshOnUnauthorized(t *testing.T) {
+	var requests int
+	server := accountServer(t, "new-key", &requests)
+	defer server.Close()
+
+	var calls int
+	client := NewClient(server.URL, "old-key")
+	client.SetAPIKeyFunc(func() (string, time.Time, error) {
+		calls++
+		return "new-key", time.Time{}, nil
+	}, time.Time{})
+
+	account, err := client.GetAccount()
+	require.NoError(t, err)
+	assert.Equal(t, "account123", account.ID)
+	assert.Equal(t, 1, calls)
+	assert.Equal(t, 2, requests)
+	assert.Equal(t, "new-key", client.APIKey)
+
+	// The refreshed key is reused for later requests
+	_, err = client.GetAccount()
+	require.NoError(t, err)
+	assert.Equal(t, 1, calls)
+	assert.Equal(t, 3, requests)
+}
+
+func TestClient_APIKeyFunc_RefreshOnExpiry(t *testing.T) {
+	var requests int
+	server := accountServer(t, "new-key", &requests)
+	defer server.Close()
+
+	var calls int
+	client := NewClient(server.URL, "old-key")
+	client.SetAPIKeyFunc(func() (string, time.Time, error) {
+		calls++
+		return "new-key", time.Now().Add(time.Hour), nil
+	}, time.Now().Add(-time.Minute))
+
+	_, err := client.GetAccount()
+	require.NoError(t, err)
+	assert.Equal(t, 1, calls)
+	assert.Equal(t, 1, requests)
+}
+
+func TestClient_APIKeyFunc_StillRejected(t *testing.T) {
+	var requests int
+	server := accountServer(t, "valid-key", &requests)
+	defer server.Close()
+
+	client := NewClient(server.URL, "old-key")
+	client.SetAPIKeyFunc(func() (string, time.Time, error) {
+		return "old-key", time.Time{}, nil
+	}, time.Time{})
+
+	_, err := client.GetAccount()
+
+	var apiErr *APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, 401, apiErr.StatusCode)
+	assert.Equal(t, 1, requests)
+}
+
+func TestClient_ReadOnly(t *testing.T) {
+	var requests int
+	server := accountServer(t, "test-api-key", &requests)
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+	client.ReadOnly = true
+
+	_, err := client.GetAccount()
+	require.NoError(t, err)
+
+	err = client.DeleteMonitor("monitor123")
+	require.ErrorIs(t, err, ErrReadOnly)
+	assert.Equal(t, 1, requests)
+}
+
+func TestClient_ListMonitors_Pagination(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/api/monitors", r.URL.Path)
+		assert.Equal(t, "100", r.URL.Query().Get("per_page"))
+
+		var resp ListMonitorsResponse
+		switch r.URL.Query().Get("page") {
+		case "1":
+			resp = ListMonitorsResponse{
+				Status: "ok",
+				Data: &ListMonitorsData{
+					Monitors:   []Monitor{{ID: "monitor1", Name: "API"}},
+					Pagination: &Pagination{Page: 1, PerPage: 100, Total: 2, TotalPages: 2, HasNext: true},
+				},
+			}
+		case "2":
+			resp = ListMonitorsResponse{
+				Status: "ok",
+				Data: &ListMonitorsData{
+					Monitors:   []Monitor{{ID: "monitor2", Name: "Website"}},
+					Pagination: &Pagination{Page: 2, PerPage: 100, Total: 2, TotalPages: 2, HasPrev: true},
+				},
+			}
+		default:
+			t.Errorf("unexpected page requested: %s", r.URL.Query().Get("page"))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(resp); err != nil {
+			t.Errorf("Failed to encode response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+
+	monitors, err := client.ListMonitors()
+
+	require.NoError(t, err)
+	require.Len(t, monitors, 2)
+	assert.Equal(t, "monitor1", monitors[0].ID)
+	assert.Equal(t, "monitor2", monitors[1].ID)
+}
+
+func TestClient_ListContactsCached(t *testing.T) {
+	var lists int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.Method {
+		case "GET":
+			lists++
+			_ = json.NewEncoder(w).Encode(ListContactsResponse{
+				Status: "ok",
+				Data:   &ListContactsData{Contacts: []Contact{{ID: "contact1", Name: "Ops"}}},
+			})
+		case "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+
+	for i := 0; i < 2; i++ {
+		contacts, err := client.ListContactsCached()
+		require.NoError(t, err)
+		assert.Len(t, contacts, 1)
+	}
+	assert.Equal(t, 1, lists)
+
+	// Deleting a contact drops the cached list
+	require.NoError(t, client.DeleteContact("contact1"))
+	_, err := client.ListContactsCached()
+	require.NoError(t, err)
+	assert.Equal(t, 2, lists)
+}
+
+func TestClient_DetachContact(t *testing.T) {
+	var updated map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/api/monitors":
+			_ = json.NewEncoder(w).Encode(ListMonitorsResponse{
+				Status: "ok",
+				Data: &ListMonitorsData{Monitors: []Monitor{
+					{ID: "monitor1", Name: "API", Contacts: []string{"contact1"}},
+					{ID: "monitor2", Name: "Website", Contacts: []string{"contact2"}},
+				}},
+			})
+		case r.Method == "PUT" && r.URL.Path == "/api/monitors/monitor1":
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&updated))
+			_ = json.NewEncoder(w).Encode(MonitorResponse{
+				Status: "ok",
+				Data:   &MonitorData{Monitor: &Monitor{ID: "monitor1"}},
+			})
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-api-key")
+
+	monitors, err := client.MonitorsUsingContact("contact1")
+	require.NoError(t, err)
+	require.Len(t, monitors, 1)
+	assert.Equal(t, "monitor1", monitors[0].ID)
+
+	// Removing the last contact must send an empty list, not omit it
+	require.NoError(t, client.SetMonitorContacts("monitor1", nil))
+	assert.Equal(t, map[string]interface{}{"contacts": []interface{}{}}, updated)
+}
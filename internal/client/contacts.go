@@ -7,11 +7,15 @@ import (
 
 // CreateContact creates a new contact
 func (c *Client) CreateContact(req *CreateContactRequest) (*Contact, error) {
-	resp, err := c.doRequest("POST", "/api/contacts", req)
+	qualified := *req
+	qualified.Name = c.qualifyName(req.Name)
+
+	resp, err := c.doRequest("POST", "/api/contacts", &qualified)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create contact: %w", err)
 	}
 	defer func() { _ = resp.Body.Close() }()
+	c.forgetContacts()
 
 	if err := c.checkResponse(resp); err != nil {
 		return nil, err
@@ -26,7 +30,10 @@ func (c *Client) CreateContact(req *CreateContactRequest) (*Contact, error) {
 		return nil, fmt.Errorf("unexpected response format")
 	}
 
-	return contactResp.Data.Contact, nil
+	contact := contactResp.Data.Contact
+	contact.Name, _ = c.unqualifyName(contact.Name)
+
+	return contact, nil
 }
 
 // GetContact retrieves a contact by ID
@@ -55,12 +62,18 @@ func (c *Client) GetContact(id string) (*Contact, error) {
 		return nil, fmt.Errorf("unexpected response format")
 	}
 
-	return contactResp.Data.Contact, nil
+	contact := contactResp.Data.Contact
+	contact.Name, _ = c.unqualifyName(contact.Name)
+
+	return contact, nil
 }
 
 // UpdateContact updates an existing contact
 func (c *Client) UpdateContact(id string, req *UpdateContactRequest) (*Contact, error) {
-	resp, err := c.doRequest("PUT", "/api/contacts/"+id, req)
+	qualified := *req
+	qualified.Name = c.qualifyNamePtr(req.Name)
+
+	resp, err := c.doRequest("PUT", "/api/contacts/"+id, &qualified)
 	if err != nil {
 		return nil, fmt.Errorf("failed to update contact: %w", err)
 	}
@@ -79,7 +92,10 @@ func (c *Client) UpdateContact(id string, req *UpdateContactRequest) (*Contact,
 		return nil, fmt.Errorf("unexpected response format")
 	}
 
-	return contactResp.Data.Contact, nil
+	contact := contactResp.Data.Contact
+	contact.Name, _ = c.unqualifyName(contact.Name)
+
+	return contact, nil
 }
 
 // DeleteContact deletes a contact
@@ -89,6 +105,7 @@ func (c *Client) DeleteContact(id string) error {
 		return fmt.Errorf("failed to delete contact: %w", err)
 	}
 	defer func() { _ = resp.Body.Close() }()
+	c.forgetContacts()
 
 	if err := c.checkResponse(resp); err != nil {
 		return err
@@ -97,8 +114,30 @@ func (c *Client) DeleteContact(id string) error {
 	return nil
 }
 
-// ListContacts retrieves all contacts
+// ListContacts retrieves the contacts of this environment: when a name
+// prefix or suffix is configured, contacts whose names lack them are skipped
 func (c *Client) ListContacts() ([]Contact, error) {
+	all, err := c.listAllContacts()
+	if err != nil {
+		return nil, err
+	}
+
+	var contacts []Contact
+	for _, contact := range all {
+		name, ok := c.unqualifyName(contact.Name)
+		if !ok {
+			continue
+		}
+		contact.Name = name
+		contacts = append(contacts, contact)
+	}
+
+	return contacts, nil
+}
+
+// listAllContacts retrieves every contact in the account with the names
+// returned by the API
+func (c *Client) listAllContacts() ([]Contact, error) {
 	resp, err := c.doRequest("GET", "/api/contacts", nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list contacts: %w", err)
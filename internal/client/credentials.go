@@ -0,0 +1,86 @@
+package client
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// keyExpirySkew is how long before its expiry a key from an APIKeyFunc is
+// considered expired, so requests never race the expiry
+const keyExpirySkew = 30 * time.Second
+
+// APIKeyFunc returns a fresh API key and the time it expires. A zero
+// expiry means the key does not expire.
+type APIKeyFunc func() (string, time.Time, error)
+
+// keySource tracks the API key obtained from an APIKeyFunc
+type keySource struct {
+	mu        sync.Mutex
+	fn        APIKeyFunc
+	expiresAt time.Time
+}
+
+// SetAPIKeyFunc makes the client obtain its API key from fn. The current
+// APIKey is used until expiresAt (zero if it does not expire); after that,
+// or when the API rejects the key, fn is called again for a new one.
+func (c *Client) SetAPIKeyFunc(fn APIKeyFunc, expiresAt time.Time) {
+	c.keys.mu.Lock()
+	defer c.keys.mu.Unlock()
+
+	c.keys.fn = fn
+	c.keys.expiresAt = expiresAt
+}
+
+// currentAPIKey returns the API key to authenticate with, refreshing it
+// first if it has expired
+func (c *Client) currentAPIKey() (string, error) {
+	c.keys.mu.Lock()
+	defer c.keys.mu.Unlock()
+
+	if c.keys.fn != nil && !c.keys.expiresAt.IsZero() && time.Now().Add(keyExpirySkew).After(c.keys.expiresAt) {
+		if err := c.refreshAPIKeyLocked(); err != nil {
+			return "", err
+		}
+	}
+
+	return c.APIKey, nil
+}
+
+// refreshAPIKey obtains a new API key after the API rejected rejectedKey.
+// It returns the key to retry with, or "" if no different key is available.
+func (c *Client) refreshAPIKey(rejectedKey string) (string, error) {
+	c.keys.mu.Lock()
+	defer c.keys.mu.Unlock()
+
+	if c.keys.fn == nil {
+		return "", nil
+	}
+
+	// Another request may have refreshed the key in the meantime
+	if c.APIKey == rejectedKey {
+		if err := c.refreshAPIKeyLocked(); err != nil {
+			return "", err
+		}
+	}
+
+	if c.APIKey == rejectedKey {
+		return "", nil
+	}
+	return c.APIKey, nil
+}
+
+// refreshAPIKeyLocked calls the APIKeyFunc; c.keys.mu must be held
+func (c *Client) refreshAPIKeyLocked() error {
+	key, expiresAt, err := c.keys.fn()
+	if err != nil {
+		return fmt.Errorf("failed to refresh API key: %w", err)
+	}
+	if key == "" {
+		return fmt.Errorf("failed to refresh API key: credential helper returned an empty key")
+	}
+
+	c.APIKey = key
+	c.keys.expiresAt = expiresAt
+	return nil
+}
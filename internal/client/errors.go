@@ -0,0 +1,20 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrReadOnly is returned for requests that would change data while the
+// client is read-only
+var ErrReadOnly = errors.New("client is read-only")
+
+// APIError is returned when the API responds with a non-2xx status code
+type APIError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("HTTP %d: %s", e.StatusCode, e.Body)
+}
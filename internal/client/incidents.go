@@ -0,0 +1,83 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// ListIncidents retrieves every incident matching the given options,
+// following pagination until the last page
+func (c *Client) ListIncidents(opts ListIncidentsOptions) ([]Incident, error) {
+	var incidents []Incident
+
+	for page := 1; ; page++ {
+		data, err := c.listIncidentsPage(opts, page)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, incident := range data.Incidents {
+			incident.MonitorName, _ = c.unqualifyName(incident.MonitorName)
+			incidents = append(incidents, incident)
+		}
+
+		if data.Pagination == nil || !data.Pagination.HasNext {
+			break
+		}
+	}
+
+	return incidents, nil
+}
+
+// listIncidentsPage retrieves a single page of incidents
+func (c *Client) listIncidentsPage(opts ListIncidentsOptions, page int) (*ListIncidentsData, error) {
+	query := url.Values{}
+	query.Set("page", strconv.Itoa(page))
+	query.Set("per_page", strconv.Itoa(listPageSize))
+	if len(opts.MonitorIDs) > 0 {
+		query.Set("monitor_ids", strings.Join(opts.MonitorIDs, ","))
+	}
+	if opts.From > 0 {
+		query.Set("from", strconv.FormatInt(opts.From, 10))
+	}
+	if opts.To > 0 {
+		query.Set("to", strconv.FormatInt(opts.To, 10))
+	}
+	if opts.Status != "" {
+		query.Set("status", opts.Status)
+	}
+
+	resp, err := c.doRequest("GET", "/api/incidents?"+query.Encode(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list incidents: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if err := c.checkResponse(resp); err != nil {
+		return nil, err
+	}
+
+	var listResp ListIncidentsResponse
+	if err := json.NewDecoder(resp.Body).Decode(&listResp); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	if listResp.Status != "ok" {
+		if listResp.Error != nil {
+			return nil, fmt.Errorf("API error: %s", *listResp.Error)
+		}
+		if listResp.Message != nil {
+			return nil, fmt.Errorf("API error: %s", *listResp.Message)
+		}
+		return nil, fmt.Errorf("API error: unknown error")
+	}
+
+	if listResp.Data == nil {
+		return nil, fmt.Errorf("invalid response: missing data")
+	}
+
+	return listResp.Data, nil
+}
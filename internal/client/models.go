@@ -181,6 +181,15 @@ type Account struct {
 	UpMonitors     int    `json:"up_monitors"`
 	DownMonitors   int    `json:"down_monitors"`
 	PausedMonitors int    `json:"paused_monitors"`
+
+	MinCheckInterval     int      `json:"min_check_interval"`
+	AllowedRegions       []string `json:"allowed_regions,omitempty"`
+	MaxRegionsPerMonitor int      `json:"max_regions_per_monitor"`
+	SMSCreditsRemaining  int      `json:"sms_credits_remaining"`
+	StatusPagesLimit     int      `json:"status_pages_limit"`
+	ContactsLimit        int      `json:"contacts_limit"`
+	EnabledChannels      []string `json:"enabled_channels,omitempty"`
+	EnabledMonitorTypes  []string `json:"enabled_monitor_types,omitempty"`
 }
 
 // AccountResponse represents the API response for account operations
@@ -249,3 +258,147 @@ type ListStatusPagesData struct {
 	StatusPages []StatusPage `json:"status_pages"`
 	Pagination  *Pagination  `json:"pagination,omitempty"`
 }
+
+// Region represents a check region monitors can run from
+type Region struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	Continent string `json:"continent"`
+	IPv4      bool   `json:"ipv4"`
+	IPv6      bool   `json:"ipv6"`
+	Available bool   `json:"available"`
+}
+
+// ListRegionsResponse represents the API response for listing regions
+type ListRegionsResponse struct {
+	Status  string           `json:"status"`
+	Data    *ListRegionsData `json:"data,omitempty"`
+	Error   *string          `json:"error,omitempty"`
+	Message *string          `json:"message,omitempty"`
+}
+
+// ListRegionsData contains the regions array
+type ListRegionsData struct {
+	Regions []Region `json:"regions"`
+}
+
+// IPRange represents the egress addresses used by checkers in a region
+type IPRange struct {
+	Region string   `json:"region"`
+	IPv4   []string `json:"ipv4"`
+	IPv6   []string `json:"ipv6"`
+}
+
+// ListIPRangesResponse represents the API response for listing checker IP ranges
+type ListIPRangesResponse struct {
+	Status  string            `json:"status"`
+	Data    *ListIPRangesData `json:"data,omitempty"`
+	Error   *string           `json:"error,omitempty"`
+	Message *string           `json:"message,omitempty"`
+}
+
+// ListIPRangesData contains the IP ranges array
+type ListIPRangesData struct {
+	Ranges []IPRange `json:"ranges"`
+}
+
+// CheckResult represents the outcome of a single check performed for a monitor
+type CheckResult struct {
+	CheckedAt    int64   `json:"checked_at"`
+	Region       string  `json:"region"`
+	Status       string  `json:"status"`
+	ResponseTime int     `json:"response_time"`
+	StatusCode   int     `json:"status_code,omitempty"`
+	Error        *string `json:"error,omitempty"`
+}
+
+// CheckResultsOptions narrows down the check results returned by the API
+type CheckResultsOptions struct {
+	From  int64
+	To    int64
+	Limit int
+}
+
+// ListCheckResultsResponse represents the API response for listing check results
+type ListCheckResultsResponse struct {
+	Status  string                `json:"status"`
+	Data    *ListCheckResultsData `json:"data,omitempty"`
+	Error   *string               `json:"error,omitempty"`
+	Message *string               `json:"message,omitempty"`
+}
+
+// ListCheckResultsData contains the check results array
+type ListCheckResultsData struct {
+	Checks []CheckResult `json:"checks"`
+}
+
+// MonitorStats represents aggregated uptime statistics for a monitor over a window
+type MonitorStats struct {
+	MonitorID        string        `json:"monitor_id"`
+	Window           string        `json:"window"`
+	UptimePercentage float64       `json:"uptime_percentage"`
+	AvgResponseTime  float64       `json:"avg_response_time"`
+	P95ResponseTime  float64       `json:"p95_response_time"`
+	IncidentCount    int           `json:"incident_count"`
+	TotalChecks      int           `json:"total_checks"`
+	FailedChecks     int           `json:"failed_checks"`
+	Regions          []RegionStats `json:"regions,omitempty"`
+}
+
+// RegionStats represents the statistics of a monitor in a single region
+type RegionStats struct {
+	Region           string  `json:"region"`
+	UptimePercentage float64 `json:"uptime_percentage"`
+	AvgResponseTime  float64 `json:"avg_response_time"`
+	P95ResponseTime  float64 `json:"p95_response_time"`
+	TotalChecks      int     `json:"total_checks"`
+	FailedChecks     int     `json:"failed_checks"`
+}
+
+// MonitorStatsResponse represents the API response for monitor statistics
+type MonitorStatsResponse struct {
+	Status  string            `json:"status"`
+	Data    *MonitorStatsData `json:"data,omitempty"`
+	Error   *string           `json:"error,omitempty"`
+	Message *string           `json:"message,omitempty"`
+}
+
+// MonitorStatsData wraps the statistics in the API response
+type MonitorStatsData struct {
+	Stats *MonitorStats `json:"stats,omitempty"`
+}
+
+// Incident represents a period during which a monitor was failing
+type Incident struct {
+	ID          string   `json:"id"`
+	MonitorID   string   `json:"monitor_id"`
+	MonitorName string   `json:"monitor_name,omitempty"`
+	Status      string   `json:"status"`
+	StartedAt   int64    `json:"started_at"`
+	ResolvedAt  int64    `json:"resolved_at,omitempty"`
+	Duration    int64    `json:"duration"`
+	Cause       string   `json:"cause,omitempty"`
+	Regions     []string `json:"regions,omitempty"`
+}
+
+// ListIncidentsOptions narrows down the incidents returned by the API
+type ListIncidentsOptions struct {
+	MonitorIDs []string
+	From       int64
+	To         int64
+	Status     string
+}
+
+// ListIncidentsResponse represents the API response for listing incidents
+type ListIncidentsResponse struct {
+	Status  string             `json:"status"`
+	Data    *ListIncidentsData `json:"data,omitempty"`
+	Error   *string            `json:"error,omitempty"`
+	Message *string            `json:"message,omitempty"`
+}
+
+// ListIncidentsData contains the incidents array and pagination info
+type ListIncidentsData struct {
+	Incidents  []Incident  `json:"incidents"`
+	Pagination *Pagination `json:"pagination,omitempty"`
+}
@@ -0,0 +1,93 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// ListMonitorCheckResults retrieves the most recent check results for a monitor
+func (c *Client) ListMonitorCheckResults(id string, opts CheckResultsOptions) ([]CheckResult, error) {
+	query := url.Values{}
+	if opts.From > 0 {
+		query.Set("from", strconv.FormatInt(opts.From, 10))
+	}
+	if opts.To > 0 {
+		query.Set("to", strconv.FormatInt(opts.To, 10))
+	}
+	if opts.Limit > 0 {
+		query.Set("limit", strconv.Itoa(opts.Limit))
+	}
+
+	path := "/api/monitors/" + id + "/checks"
+	if len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+
+	resp, err := c.doRequest("GET", path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list check results: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if err := c.checkResponse(resp); err != nil {
+		return nil, err
+	}
+
+	var listResp ListCheckResultsResponse
+	if err := json.NewDecoder(resp.Body).Decode(&listResp); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	if listResp.Status != "ok" {
+		if listResp.Error != nil {
+			return nil, fmt.Errorf("API error: %s", *listResp.Error)
+		}
+		if listResp.Message != nil {
+			return nil, fmt.Errorf("API error: %s", *listResp.Message)
+		}
+		return nil, fmt.Errorf("API error: unknown error")
+	}
+
+	if listResp.Data == nil {
+		return nil, fmt.Errorf("invalid response: missing data")
+	}
+
+	return listResp.Data.Checks, nil
+}
+
+// GetMonitorStats retrieves aggregated statistics for a monitor over the
+// given window (e.g. "24h", "7d", "30d", "90d")
+func (c *Client) GetMonitorStats(id, window string) (*MonitorStats, error) {
+	resp, err := c.doRequest("GET", "/api/monitors/"+id+"/stats?window="+url.QueryEscape(window), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get monitor stats: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if err := c.checkResponse(resp); err != nil {
+		return nil, err
+	}
+
+	var statsResp MonitorStatsResponse
+	if err := json.NewDecoder(resp.Body).Decode(&statsResp); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	if statsResp.Status != "ok" {
+		if statsResp.Error != nil {
+			return nil, fmt.Errorf("API error: %s", *statsResp.Error)
+		}
+		if statsResp.Message != nil {
+			return nil, fmt.Errorf("API error: %s", *statsResp.Message)
+		}
+		return nil, fmt.Errorf("API error: unknown error")
+	}
+
+	if statsResp.Data == nil || statsResp.Data.Stats == nil {
+		return nil, fmt.Errorf("invalid response: missing stats data")
+	}
+
+	return statsResp.Data.Stats, nil
+}
@@ -5,15 +5,19 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"slices"
 )
 
 // CreateMonitor creates a new monitor
 func (c *Client) CreateMonitor(req CreateMonitorRequest) (*Monitor, error) {
+	req.Name = c.qualifyName(req.Name)
+
 	resp, err := c.doRequest("POST", "/api/monitors", req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create monitor: %w", err)
 	}
 	defer func() { _ = resp.Body.Close() }()
+	c.forgetMonitors()
 
 	if err := c.checkResponse(resp); err != nil {
 		return nil, err
@@ -38,7 +42,10 @@ func (c *Client) CreateMonitor(req CreateMonitorRequest) (*Monitor, error) {
 		return nil, fmt.Errorf("invalid response: missing monitor data")
 	}
 
-	return monitorResp.Data.Monitor, nil
+	monitor := monitorResp.Data.Monitor
+	monitor.Name, _ = c.unqualifyName(monitor.Name)
+
+	return monitor, nil
 }
 
 // GetMonitor retrieves a monitor by ID
@@ -76,11 +83,16 @@ func (c *Client) GetMonitor(id string) (*Monitor, error) {
 		return nil, fmt.Errorf("invalid response: missing monitor data")
 	}
 
-	return monitorResp.Data.Monitor, nil
+	monitor := monitorResp.Data.Monitor
+	monitor.Name, _ = c.unqualifyName(monitor.Name)
+
+	return monitor, nil
 }
 
 // UpdateMonitor updates an existing monitor
 func (c *Client) UpdateMonitor(id string, req UpdateMonitorRequest) (*Monitor, error) {
+	req.Name = c.qualifyNamePtr(req.Name)
+
 	resp, err := c.doRequest("PUT", "/api/monitors/"+id, req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to update monitor: %w", err)
@@ -110,7 +122,65 @@ func (c *Client) UpdateMonitor(id string, req UpdateMonitorRequest) (*Monitor, e
 		return nil, fmt.Errorf("invalid response: missing monitor data")
 	}
 
-	return monitorResp.Data.Monitor, nil
+	monitor := monitorResp.Data.Monitor
+	monitor.Name, _ = c.unqualifyName(monitor.Name)
+
+	return monitor, nil
+}
+
+// SetMonitorContacts replaces the contacts notified by a monitor. Unlike
+// UpdateMonitor it can remove the last contact.
+func (c *Client) SetMonitorContacts(id string, contacts []string) error {
+	req := struct {
+		Contacts []string `json:"contacts"`
+	}{
+		Contacts: append([]string{}, contacts...),
+	}
+
+	resp, err := c.doRequest("PUT", "/api/monitors/"+id, req)
+	if err != nil {
+		return fmt.Errorf("failed to update monitor contacts: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if err := c.checkResponse(resp); err != nil {
+		return err
+	}
+
+	var monitorResp MonitorResponse
+	if err := json.NewDecoder(resp.Body).Decode(&monitorResp); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	if monitorResp.Status != "ok" {
+		if monitorResp.Error != nil {
+			return fmt.Errorf("API error: %s", *monitorResp.Error)
+		}
+		if monitorResp.Message != nil {
+			return fmt.Errorf("API error: %s", *monitorResp.Message)
+		}
+		return fmt.Errorf("API error: unknown error")
+	}
+
+	return nil
+}
+
+// MonitorsUsingContact returns the monitors that notify the given contact,
+// including those of other environments sharing the account
+func (c *Client) MonitorsUsingContact(contactID string) ([]Monitor, error) {
+	monitors, err := c.listAllMonitors()
+	if err != nil {
+		return nil, err
+	}
+
+	var using []Monitor
+	for _, monitor := range monitors {
+		if slices.Contains(monitor.Contacts, contactID) {
+			using = append(using, monitor)
+		}
+	}
+
+	return using, nil
 }
 
 // DeleteMonitor deletes a monitor by ID
@@ -120,6 +190,7 @@ func (c *Client) DeleteMonitor(id string) error {
 		return fmt.Errorf("failed to delete monitor: %w", err)
 	}
 	defer func() { _ = resp.Body.Close() }()
+	c.forgetMonitors()
 
 	if resp.StatusCode == http.StatusNotFound {
 		return nil // Monitor already doesn't exist
@@ -132,9 +203,52 @@ func (c *Client) DeleteMonitor(id string) error {
 	return nil
 }
 
-// ListMonitors retrieves all monitors for the authenticated account
+// ListMonitors retrieves the monitors of this environment: when a name
+// prefix or suffix is configured, monitors whose names lack them are skipped
 func (c *Client) ListMonitors() ([]Monitor, error) {
-	resp, err := c.doRequest("GET", "/api/monitors", nil)
+	all, err := c.listAllMonitors()
+	if err != nil {
+		return nil, err
+	}
+
+	var monitors []Monitor
+	for _, monitor := range all {
+		name, ok := c.unqualifyName(monitor.Name)
+		if !ok {
+			continue
+		}
+		monitor.Name = name
+		monitors = append(monitors, monitor)
+	}
+
+	return monitors, nil
+}
+
+// listAllMonitors retrieves every monitor in the account with the names
+// returned by the API, following pagination until the last page has been
+// fetched
+func (c *Client) listAllMonitors() ([]Monitor, error) {
+	var monitors []Monitor
+
+	for page := 1; ; page++ {
+		data, err := c.listMonitorsPage(page)
+		if err != nil {
+			return nil, err
+		}
+
+		monitors = append(monitors, data.Monitors...)
+
+		if data.Pagination == nil || !data.Pagination.HasNext {
+			break
+		}
+	}
+
+	return monitors, nil
+}
+
+// listMonitorsPage retrieves a single page of monitors
+func (c *Client) listMonitorsPage(page int) (*ListMonitorsData, error) {
+	resp, err := c.doRequest("GET", fmt.Sprintf("/api/monitors?page=%d&per_page=%d", page, listPageSize), nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list monitors: %w", err)
 	}
@@ -168,5 +282,5 @@ func (c *Client) ListMonitors() ([]Monitor, error) {
 		return nil, fmt.Errorf("invalid response: missing data")
 	}
 
-	return listResp.Data.Monitors, nil
+	return listResp.Data, nil
 }
@@ -0,0 +1,29 @@
+package client
+
+import "strings"
+
+// qualifyName adds the configured name prefix and suffix to a name sent to the API
+func (c *Client) qualifyName(name string) string {
+	return c.NamePrefix + name + c.NameSuffix
+}
+
+// qualifyNamePtr is qualifyName for optional names in update requests
+func (c *Client) qualifyNamePtr(name *string) *string {
+	if name == nil {
+		return nil
+	}
+	qualified := c.qualifyName(*name)
+	return &qualified
+}
+
+// unqualifyName removes the configured name prefix and suffix from a name
+// returned by the API, and reports whether the name carried both. Names
+// that do not are returned unchanged; they belong to another environment
+// sharing the account.
+func (c *Client) unqualifyName(name string) (string, bool) {
+	if len(name) < len(c.NamePrefix)+len(c.NameSuffix) ||
+		!strings.HasPrefix(name, c.NamePrefix) || !strings.HasSuffix(name, c.NameSuffix) {
+		return name, false
+	}
+	return name[len(c.NamePrefix) : len(name)-len(c.NameSuffix)], true
+}
@@ -0,0 +1,74 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// ListRegions retrieves the check regions available to the authenticated account
+func (c *Client) ListRegions() ([]Region, error) {
+	resp, err := c.doRequest("GET", "/api/regions", nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list regions: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if err := c.checkResponse(resp); err != nil {
+		return nil, err
+	}
+
+	var listResp ListRegionsResponse
+	if err := json.NewDecoder(resp.Body).Decode(&listResp); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	if listResp.Status != "ok" {
+		if listResp.Error != nil {
+			return nil, fmt.Errorf("API error: %s", *listResp.Error)
+		}
+		if listResp.Message != nil {
+			return nil, fmt.Errorf("API error: %s", *listResp.Message)
+		}
+		return nil, fmt.Errorf("API error: unknown error")
+	}
+
+	if listResp.Data == nil {
+		return nil, fmt.Errorf("invalid response: missing data")
+	}
+
+	return listResp.Data.Regions, nil
+}
+
+// ListIPRanges retrieves the checker egress IP ranges for every region
+func (c *Client) ListIPRanges() ([]IPRange, error) {
+	resp, err := c.doRequest("GET", "/api/ip_ranges", nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list IP ranges: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if err := c.checkResponse(resp); err != nil {
+		return nil, err
+	}
+
+	var listResp ListIPRangesResponse
+	if err := json.NewDecoder(resp.Body).Decode(&listResp); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	if listResp.Status != "ok" {
+		if listResp.Error != nil {
+			return nil, fmt.Errorf("API error: %s", *listResp.Error)
+		}
+		if listResp.Message != nil {
+			return nil, fmt.Errorf("API error: %s", *listResp.Message)
+		}
+		return nil, fmt.Errorf("API error: unknown error")
+	}
+
+	if listResp.Data == nil {
+		return nil, fmt.Errorf("invalid response: missing data")
+	}
+
+	return listResp.Data.Ranges, nil
+}
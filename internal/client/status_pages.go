@@ -1,30 +1,19 @@
 package client
 
 import (
-	"bytes"
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"slices"
 )
 
 // CreateStatusPage creates a new status page
 func (c *Client) CreateStatusPage(req CreateStatusPageRequest) (*StatusPage, error) {
-	body, err := json.Marshal(req)
-	if err != nil {
-		return nil, fmt.Errorf("failed to marshal request: %w", err)
-	}
-
-	httpReq, err := http.NewRequest("POST", fmt.Sprintf("%s/api/status_pages", c.BaseURL), bytes.NewBuffer(body))
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
-	}
-
-	httpReq.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.APIKey))
-	httpReq.Header.Set("Content-Type", "application/json")
+	req.Name = c.qualifyName(req.Name)
 
-	resp, err := c.HTTPClient.Do(httpReq)
+	resp, err := c.doRequest("POST", "/api/status_pages", req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to make request: %w", err)
+		return nil, fmt.Errorf("failed to create status page: %w", err)
 	}
 	defer func() { _ = resp.Body.Close() }()
 
@@ -47,21 +36,17 @@ func (c *Client) CreateStatusPage(req CreateStatusPageRequest) (*StatusPage, err
 		return nil, fmt.Errorf("no status page data in response")
 	}
 
-	return apiResp.Data.StatusPage, nil
+	statusPage := apiResp.Data.StatusPage
+	statusPage.Name, _ = c.unqualifyName(statusPage.Name)
+
+	return statusPage, nil
 }
 
 // GetStatusPage retrieves a status page by ID
 func (c *Client) GetStatusPage(id string) (*StatusPage, error) {
-	httpReq, err := http.NewRequest("GET", fmt.Sprintf("%s/api/status_pages/%s", c.BaseURL, id), nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
-	}
-
-	httpReq.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.APIKey))
-
-	resp, err := c.HTTPClient.Do(httpReq)
+	resp, err := c.doRequest("GET", "/api/status_pages/"+id, nil)
 	if err != nil {
-		return nil, fmt.Errorf("failed to make request: %w", err)
+		return nil, fmt.Errorf("failed to get status page: %w", err)
 	}
 	defer func() { _ = resp.Body.Close() }()
 
@@ -88,27 +73,19 @@ func (c *Client) GetStatusPage(id string) (*StatusPage, error) {
 		return nil, fmt.Errorf("no status page data in response")
 	}
 
-	return apiResp.Data.StatusPage, nil
+	statusPage := apiResp.Data.StatusPage
+	statusPage.Name, _ = c.unqualifyName(statusPage.Name)
+
+	return statusPage, nil
 }
 
 // UpdateStatusPage updates an existing status page
 func (c *Client) UpdateStatusPage(id string, req UpdateStatusPageRequest) (*StatusPage, error) {
-	body, err := json.Marshal(req)
-	if err != nil {
-		return nil, fmt.Errorf("failed to marshal request: %w", err)
-	}
-
-	httpReq, err := http.NewRequest("PATCH", fmt.Sprintf("%s/api/status_pages/%s", c.BaseURL, id), bytes.NewBuffer(body))
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
-	}
-
-	httpReq.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.APIKey))
-	httpReq.Header.Set("Content-Type", "application/json")
+	req.Name = c.qualifyNamePtr(req.Name)
 
-	resp, err := c.HTTPClient.Do(httpReq)
+	resp, err := c.doRequest("PATCH", "/api/status_pages/"+id, req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to make request: %w", err)
+		return nil, fmt.Errorf("failed to update status page: %w", err)
 	}
 	defer func() { _ = resp.Body.Close() }()
 
@@ -131,21 +108,41 @@ func (c *Client) UpdateStatusPage(id string, req UpdateStatusPageRequest) (*Stat
 		return nil, fmt.Errorf("no status page data in response")
 	}
 
-	return apiResp.Data.StatusPage, nil
+	statusPage := apiResp.Data.StatusPage
+	statusPage.Name, _ = c.unqualifyName(statusPage.Name)
+
+	return statusPage, nil
 }
 
-// DeleteStatusPage deletes a status page
-func (c *Client) DeleteStatusPage(id string) error {
-	httpReq, err := http.NewRequest("DELETE", fmt.Sprintf("%s/api/status_pages/%s", c.BaseURL, id), nil)
+// SetStatusPageMonitors replaces the monitors shown on a status page
+func (c *Client) SetStatusPageMonitors(id string, monitors []string) error {
+	_, err := c.UpdateStatusPage(id, UpdateStatusPageRequest{Monitors: monitors})
+	return err
+}
+
+// StatusPagesShowingMonitor returns the status pages that show the given
+// monitor, including those of other environments sharing the account
+func (c *Client) StatusPagesShowingMonitor(monitorID string) ([]StatusPage, error) {
+	statusPages, err := c.listAllStatusPages()
 	if err != nil {
-		return fmt.Errorf("failed to create request: %w", err)
+		return nil, err
+	}
+
+	var showing []StatusPage
+	for _, statusPage := range statusPages {
+		if slices.Contains(statusPage.Monitors, monitorID) {
+			showing = append(showing, statusPage)
+		}
 	}
 
-	httpReq.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.APIKey))
+	return showing, nil
+}
 
-	resp, err := c.HTTPClient.Do(httpReq)
+// DeleteStatusPage deletes a status page
+func (c *Client) DeleteStatusPage(id string) error {
+	resp, err := c.doRequest("DELETE", "/api/status_pages/"+id, nil)
 	if err != nil {
-		return fmt.Errorf("failed to make request: %w", err)
+		return fmt.Errorf("failed to delete status page: %w", err)
 	}
 	defer func() { _ = resp.Body.Close() }()
 
@@ -165,18 +162,55 @@ func (c *Client) DeleteStatusPage(id string) error {
 	return nil
 }
 
-// ListStatusPages retrieves all status pages
+// ListStatusPages retrieves the status pages of this environment: when a
+// name prefix or suffix is configured, status pages whose names lack them
+// are skipped
 func (c *Client) ListStatusPages() ([]StatusPage, error) {
-	httpReq, err := http.NewRequest("GET", fmt.Sprintf("%s/api/status_pages", c.BaseURL), nil)
+	all, err := c.listAllStatusPages()
 	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+		return nil, err
+	}
+
+	var statusPages []StatusPage
+	for _, statusPage := range all {
+		name, ok := c.unqualifyName(statusPage.Name)
+		if !ok {
+			continue
+		}
+		statusPage.Name = name
+		statusPages = append(statusPages, statusPage)
 	}
 
-	httpReq.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.APIKey))
+	return statusPages, nil
+}
+
+// listAllStatusPages retrieves every status page in the account with the
+// names returned by the API, following pagination until the last page has
+// been fetched
+func (c *Client) listAllStatusPages() ([]StatusPage, error) {
+	var statusPages []StatusPage
+
+	for page := 1; ; page++ {
+		data, err := c.listStatusPagesPage(page)
+		if err != nil {
+			return nil, err
+		}
+
+		statusPages = append(statusPages, data.StatusPages...)
+
+		if data.Pagination == nil || !data.Pagination.HasNext {
+			break
+		}
+	}
+
+	return statusPages, nil
+}
 
-	resp, err := c.HTTPClient.Do(httpReq)
+// listStatusPagesPage retrieves a single page of status pages
+func (c *Client) listStatusPagesPage(page int) (*ListStatusPagesData, error) {
+	resp, err := c.doRequest("GET", fmt.Sprintf("/api/status_pages?page=%d&per_page=%d", page, listPageSize), nil)
 	if err != nil {
-		return nil, fmt.Errorf("failed to make request: %w", err)
+		return nil, fmt.Errorf("failed to list status pages: %w", err)
 	}
 	defer func() { _ = resp.Body.Close() }()
 
@@ -199,5 +233,5 @@ func (c *Client) ListStatusPages() ([]StatusPage, error) {
 		return nil, fmt.Errorf("no data in response")
 	}
 
-	return apiResp.Data.StatusPages, nil
+	return apiResp.Data, nil
 }
@@ -0,0 +1,72 @@
+package config
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"runtime"
+	"strings"
+	"time"
+)
+
+// commandTimeout bounds how long an api_key_command may run
+const commandTimeout = time.Minute
+
+// commandOutput is the JSON an api_key_command prints on stdout
+type commandOutput struct {
+	APIKey    string `json:"api_key"`
+	ExpiresAt string `json:"expires_at,omitempty"`
+}
+
+// RunAPIKeyCommand runs command through the system shell and parses the
+// {"api_key": "...", "expires_at": "<RFC 3339>"} object it prints. The
+// expiry is optional; a zero time is returned when it is not set.
+func RunAPIKeyCommand(command string) (string, time.Time, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), commandTimeout)
+	defer cancel()
+
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.CommandContext(ctx, "cmd", "/C", command)
+	} else {
+		cmd = exec.CommandContext(ctx, "sh", "-c", command)
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return "", time.Time{}, fmt.Errorf("api_key_command failed: %w: %s", err, msg)
+		}
+		return "", time.Time{}, fmt.Errorf("api_key_command failed: %w", err)
+	}
+
+	return parseCommandOutput(stdout.Bytes())
+}
+
+// parseCommandOutput parses the JSON printed by an api_key_command
+func parseCommandOutput(output []byte) (string, time.Time, error) {
+	var out commandOutput
+	if err := json.Unmarshal(output, &out); err != nil {
+		return "", time.Time{}, fmt.Errorf("api_key_command did not print a JSON object: %w", err)
+	}
+
+	if out.APIKey == "" {
+		return "", time.Time{}, fmt.Errorf("api_key_command output has no api_key")
+	}
+
+	var expiresAt time.Time
+	if out.ExpiresAt != "" {
+		var err error
+		expiresAt, err = time.Parse(time.RFC3339, out.ExpiresAt)
+		if err != nil {
+			return "", time.Time{}, fmt.Errorf("api_key_command returned an invalid expires_at: %w", err)
+		}
+	}
+
+	return out.APIKey, expiresAt, nil
+}
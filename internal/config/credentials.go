@@ -0,0 +1,247 @@
+package config
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// DefaultBaseURL is the production API used when no base URL is configured
+const DefaultBaseURL = "https://api.uptime-monitor.io"
+
+// DefaultProfile is the profile read from the credentials file when none is selected
+const DefaultProfile = "default"
+
+// Environment variables read by Resolve
+const (
+	EnvAPIKey     = "UPTIME_API_KEY"
+	EnvBaseURL    = "UPTIME_BASE_URL"
+	EnvProfile    = "UPTIME_PROFILE"
+	EnvConfigFile = "UPTIME_CONFIG_FILE"
+)
+
+// Profile holds the settings of a named profile in the credentials file
+type Profile struct {
+	APIKey  string
+	BaseURL string
+}
+
+// CredentialsPath returns the location of the credentials file:
+// UPTIME_CONFIG_FILE if set, otherwise ~/.config/uptime/credentials
+func CredentialsPath() (string, error) {
+	if path := os.Getenv(EnvConfigFile); path != "" {
+		return path, nil
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("unable to determine home directory: %w", err)
+	}
+
+	return filepath.Join(home, ".config", "uptime", "credentials"), nil
+}
+
+// LoadProfiles parses an INI style credentials file:
+//
+//	[default]
+//	api_key = ...
+//
+//	[staging]
+//	api_key  = ...
+//	base_url = https://staging.example.com
+func LoadProfiles(path string) (map[string]Profile, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = file.Close() }()
+
+	profiles := make(map[string]Profile)
+	current := ""
+
+	scanner := bufio.NewScanner(file)
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			current = strings.TrimSpace(line[1 : len(line)-1])
+			if current == "" {
+				return nil, fmt.Errorf("%s:%d: empty profile name", path, lineNo)
+			}
+			if _, ok := profiles[current]; !ok {
+				profiles[current] = Profile{}
+			}
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil, fmt.Errorf("%s:%d: expected key = value", path, lineNo)
+		}
+		if current == "" {
+			return nil, fmt.Errorf("%s:%d: setting outside of a [profile] section", path, lineNo)
+		}
+
+		key = strings.TrimSpace(key)
+		value = strings.Trim(strings.TrimSpace(value), `"`)
+
+		profile := profiles[current]
+		switch key {
+		case "api_key":
+			profile.APIKey = value
+		case "base_url":
+			profile.BaseURL = value
+		default:
+			return nil, fmt.Errorf("%s:%d: unknown setting %q", path, lineNo, key)
+		}
+		profiles[current] = profile
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	return profiles, nil
+}
+
+// Options holds the values set explicitly in the provider configuration.
+// Empty strings mean the value was not set.
+type Options struct {
+	APIKey        string
+	APIKeyCommand string
+	BaseURL       string
+	Profile       string
+}
+
+// Resolved holds the effective configuration and where each value came from
+type Resolved struct {
+	APIKey        string
+	APIKeySource  string
+	BaseURL       string
+	BaseURLSource string
+
+	// APIKeyExpiresAt is when a key from api_key_command expires (zero if it
+	// does not) and RefreshAPIKey runs the command again for a new key. Both
+	// are unset for keys from other sources.
+	APIKeyExpiresAt time.Time
+	RefreshAPIKey   func() (string, time.Time, error)
+
+	// Profile is the selected profile name and ProfilePath the credentials
+	// file it was looked up in, even if the file does not exist
+	Profile     string
+	ProfilePath string
+}
+
+// Resolve determines the API key and base URL. Each value is taken from the
+// first of these that sets it:
+//
+//  1. the provider configuration (opts), where api_key_command is run to
+//     obtain the API key
+//  2. the UPTIME_API_KEY / UPTIME_BASE_URL environment variables
+//  3. the selected profile in the credentials file
+//
+// The profile is selected by opts.Profile, then UPTIME_PROFILE, then
+// "default". A profile selected explicitly by either is meant to be used, so
+// it comes before the environment variables, which then only fill in what
+// it leaves unset. A missing file or profile is only an error when a
+// profile was selected explicitly. The base URL falls back to
+// DefaultBaseURL.
+func Resolve(opts Options) (*Resolved, error) {
+	resolved := &Resolved{}
+
+	explicitProfile := true
+	resolved.Profile = opts.Profile
+	if resolved.Profile == "" {
+		resolved.Profile = os.Getenv(EnvProfile)
+	}
+	if resolved.Profile == "" {
+		resolved.Profile = DefaultProfile
+		explicitProfile = false
+	}
+
+	path, err := CredentialsPath()
+	if err != nil && explicitProfile {
+		return nil, err
+	}
+	resolved.ProfilePath = path
+
+	var profile Profile
+	if path != "" {
+		profiles, err := LoadProfiles(path)
+		switch {
+		case errors.Is(err, fs.ErrNotExist):
+			if explicitProfile {
+				return nil, fmt.Errorf("profile %q was selected but the credentials file %s does not exist", resolved.Profile, path)
+			}
+		case err != nil:
+			return nil, err
+		default:
+			p, ok := profiles[resolved.Profile]
+			if !ok && explicitProfile {
+				return nil, fmt.Errorf("profile %q not found in %s", resolved.Profile, path)
+			}
+			profile = p
+		}
+	}
+
+	profileSource := fmt.Sprintf("profile %q in %s", resolved.Profile, path)
+
+	if opts.APIKey == "" && opts.APIKeyCommand != "" {
+		command := opts.APIKeyCommand
+		resolved.RefreshAPIKey = func() (string, time.Time, error) {
+			return RunAPIKeyCommand(command)
+		}
+
+		opts.APIKey, resolved.APIKeyExpiresAt, err = resolved.RefreshAPIKey()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	configSource := "provider configuration"
+	if resolved.RefreshAPIKey != nil {
+		configSource = "api_key_command"
+	}
+
+	apiKeys := []setting{{opts.APIKey, configSource}}
+	baseURLs := []setting{{opts.BaseURL, "provider configuration"}}
+
+	envAPIKey, envBaseURL := setting{os.Getenv(EnvAPIKey), EnvAPIKey}, setting{os.Getenv(EnvBaseURL), EnvBaseURL}
+	profileAPIKey, profileBaseURL := setting{profile.APIKey, profileSource}, setting{profile.BaseURL, profileSource}
+	if explicitProfile {
+		apiKeys = append(apiKeys, profileAPIKey, envAPIKey)
+		baseURLs = append(baseURLs, profileBaseURL, envBaseURL)
+	} else {
+		apiKeys = append(apiKeys, envAPIKey, profileAPIKey)
+		baseURLs = append(baseURLs, envBaseURL, profileBaseURL)
+	}
+
+	resolved.APIKey, resolved.APIKeySource = firstSet(apiKeys...)
+	resolved.BaseURL, resolved.BaseURLSource = firstSet(append(baseURLs, setting{DefaultBaseURL, "default"})...)
+
+	return resolved, nil
+}
+
+// setting is a candidate value together with where it came from
+type setting struct {
+	value  string
+	source string
+}
+
+// firstSet returns the first non-empty setting
+func firstSet(settings ...setting) (string, string) {
+	for _, s := range settings {
+		if s.value != "" {
+			return s.value, s.source
+		}
+	}
+	return "", ""
+}
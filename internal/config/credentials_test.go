@@ -0,0 +1,212 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testCredentials = `# Uptime credentials
+[default]
+api_key = default-key
+
+[staging]
+api_key  = "staging-key"
+base_url = https://staging.example.com
+`
+
+// writeCredentials writes a credentials file and points UPTIME_CONFIG_FILE at it
+func writeCredentials(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "credentials")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	t.Setenv(EnvConfigFile, path)
+	return path
+}
+
+// clearEnv unsets the environment variables read by Resolve
+func clearEnv(t *testing.T) {
+	for _, name := range []string{EnvAPIKey, EnvBaseURL, EnvProfile, EnvConfigFile} {
+		t.Setenv(name, "")
+	}
+}
+
+func TestLoadProfiles(t *testing.T) {
+	clearEnv(t)
+	path := writeCredentials(t, testCredentials)
+
+	profiles, err := LoadProfiles(path)
+
+	require.NoError(t, err)
+	assert.Equal(t, map[string]Profile{
+		"default": {APIKey: "default-key"},
+		"staging": {APIKey: "staging-key", BaseURL: "https://staging.example.com"},
+	}, profiles)
+}
+
+func TestLoadProfiles_Invalid(t *testing.T) {
+	tests := map[string]string{
+		"setting outside section": "api_key = x\n",
+		"unknown setting":         "[default]\ntoken = x\n",
+		"missing equals":          "[default]\napi_key\n",
+	}
+
+	for name, content := range tests {
+		t.Run(name, func(t *testing.T) {
+			clearEnv(t)
+			_, err := LoadProfiles(writeCredentials(t, content))
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestResolve(t *testing.T) {
+	tests := []struct {
+		name            string
+		opts            Options
+		env             map[string]string
+		noFile          bool
+		wantAPIKey      string
+		wantBaseURL     string
+		wantKeySource   string
+		wantErrContains string
+	}{
+		{
+			name:          "default profile",
+			wantAPIKey:    "default-key",
+			wantBaseURL:   DefaultBaseURL,
+			wantKeySource: `profile "default"`,
+		},
+		{
+			name:          "profile from environment",
+			env:           map[string]string{EnvProfile: "staging"},
+			wantAPIKey:    "staging-key",
+			wantBaseURL:   "https://staging.example.com",
+			wantKeySource: `profile "staging"`,
+		},
+		{
+			name:          "environment overrides default profile",
+			env:           map[string]string{EnvAPIKey: "env-key", EnvBaseURL: "https://env.example.com"},
+			wantAPIKey:    "env-key",
+			wantBaseURL:   "https://env.example.com",
+			wantKeySource: EnvAPIKey,
+		},
+		{
+			name:          "selected profile overrides environment",
+			opts:          Options{Profile: "staging"},
+			env:           map[string]string{EnvAPIKey: "env-key", EnvBaseURL: "https://env.example.com"},
+			wantAPIKey:    "staging-key",
+			wantBaseURL:   "https://staging.example.com",
+			wantKeySource: `profile "staging"`,
+		},
+		{
+			name:          "profile selected by environment overrides environment",
+			env:           map[string]string{EnvProfile: "staging", EnvAPIKey: "env-key"},
+			wantAPIKey:    "staging-key",
+			wantBaseURL:   "https://staging.example.com",
+			wantKeySource: `profile "staging"`,
+		},
+		{
+			name:          "environment fills settings missing from selected profile",
+			opts:          Options{Profile: "default"},
+			env:           map[string]string{EnvBaseURL: "https://env.example.com"},
+			wantAPIKey:    "default-key",
+			wantBaseURL:   "https://env.example.com",
+			wantKeySource: `profile "default"`,
+		},
+		{
+			name:          "configuration overrides environment",
+			opts:          Options{APIKey: "config-key", BaseURL: "https://config.example.com"},
+			env:           map[string]string{EnvAPIKey: "env-key"},
+			wantAPIKey:    "config-key",
+			wantBaseURL:   "https://config.example.com",
+			wantKeySource: "provider configuration",
+		},
+		{
+			name:            "unknown profile",
+			opts:            Options{Profile: "production"},
+			wantErrContains: `profile "production" not found`,
+		},
+		{
+			name:        "no file without explicit profile",
+			noFile:      true,
+			wantAPIKey:  "",
+			wantBaseURL: DefaultBaseURL,
+		},
+		{
+			name:            "no file with explicit profile",
+			opts:            Options{Profile: "staging"},
+			noFile:          true,
+			wantErrContains: "does not exist",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clearEnv(t)
+			if tt.noFile {
+				t.Setenv(EnvConfigFile, filepath.Join(t.TempDir(), "missing"))
+			} else {
+				writeCredentials(t, testCredentials)
+			}
+			for k, v := range tt.env {
+				t.Setenv(k, v)
+			}
+
+			resolved, err := Resolve(tt.opts)
+
+			if tt.wantErrContains != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErrContains)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantAPIKey, resolved.APIKey)
+			assert.Equal(t, tt.wantBaseURL, resolved.BaseURL)
+			assert.Contains(t, resolved.APIKeySource, tt.wantKeySource)
+		})
+	}
+}
+
+func TestParseCommandOutput(t *testing.T) {
+	key, expiresAt, err := parseCommandOutput([]byte(`{"api_key": "secret", "expires_at": "2030-01-02T03:04:05Z"}`))
+	require.NoError(t, err)
+	assert.Equal(t, "secret", key)
+	assert.Equal(t, time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC), expiresAt)
+
+	key, expiresAt, err = parseCommandOutput([]byte(`{"api_key": "secret"}`))
+	require.NoError(t, err)
+	assert.Equal(t, "secret", key)
+	assert.True(t, expiresAt.IsZero())
+
+	for _, output := range []string{`not json`, `{}`, `{"api_key": "secret", "expires_at": "tomorrow"}`} {
+		_, _, err := parseCommandOutput([]byte(output))
+		assert.Error(t, err, output)
+	}
+}
+
+func TestResolve_APIKeyCommand(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("uses a POSIX shell command")
+	}
+	clearEnv(t)
+	writeCredentials(t, testCredentials)
+
+	resolved, err := Resolve(Options{APIKeyCommand: `echo '{"api_key": "command-key"}'`})
+	require.NoError(t, err)
+	assert.Equal(t, "command-key", resolved.APIKey)
+	assert.Equal(t, "api_key_command", resolved.APIKeySource)
+	require.NotNil(t, resolved.RefreshAPIKey)
+
+	key, _, err := resolved.RefreshAPIKey()
+	require.NoError(t, err)
+	assert.Equal(t, "command-key", key)
+
+	_, err = Resolve(Options{APIKeyCommand: "echo broken >&2; exit 1"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "broken")
+}
@@ -32,6 +32,15 @@ type AccountDataSourceModel struct {
 	UpMonitors     types.Int64  `tfsdk:"up_monitors"`
 	DownMonitors   types.Int64  `tfsdk:"down_monitors"`
 	PausedMonitors types.Int64  `tfsdk:"paused_monitors"`
+
+	MinCheckInterval     types.Int64 `tfsdk:"min_check_interval"`
+	AllowedRegions       types.List  `tfsdk:"allowed_regions"`
+	MaxRegionsPerMonitor types.Int64 `tfsdk:"max_regions_per_monitor"`
+	SMSCreditsRemaining  types.Int64 `tfsdk:"sms_credits_remaining"`
+	StatusPagesLimit     types.Int64 `tfsdk:"status_pages_limit"`
+	ContactsLimit        types.Int64 `tfsdk:"contacts_limit"`
+	EnabledChannels      types.List  `tfsdk:"enabled_channels"`
+	EnabledMonitorTypes  types.List  `tfsdk:"enabled_monitor_types"`
 }
 
 func (d *AccountDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -75,6 +84,41 @@ func (d *AccountDataSource) Schema(ctx context.Context, req datasource.SchemaReq
 				MarkdownDescription: "Number of paused monitors",
 				Computed:            true,
 			},
+			"min_check_interval": schema.Int64Attribute{
+				MarkdownDescription: "Shortest check interval in seconds allowed by the current plan",
+				Computed:            true,
+			},
+			"allowed_regions": schema.ListAttribute{
+				MarkdownDescription: "Check regions available on the current plan",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"max_regions_per_monitor": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of regions a single monitor can check from",
+				Computed:            true,
+			},
+			"sms_credits_remaining": schema.Int64Attribute{
+				MarkdownDescription: "Number of SMS notifications that can still be sent",
+				Computed:            true,
+			},
+			"status_pages_limit": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of status pages allowed by the current plan",
+				Computed:            true,
+			},
+			"contacts_limit": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of contacts allowed by the current plan",
+				Computed:            true,
+			},
+			"enabled_channels": schema.ListAttribute{
+				MarkdownDescription: "Contact channels available on the current plan, e.g. `email`, `slack`",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"enabled_monitor_types": schema.ListAttribute{
+				MarkdownDescription: "Monitor types available on the current plan, e.g. `https`, `tcp`, `ping`",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
 		},
 	}
 }
@@ -125,7 +169,33 @@ func (d *AccountDataSource) Read(ctx context.Context, req datasource.ReadRequest
 	data.UpMonitors = types.Int64Value(int64(account.UpMonitors))
 	data.DownMonitors = types.Int64Value(int64(account.DownMonitors))
 	data.PausedMonitors = types.Int64Value(int64(account.PausedMonitors))
+	data.MinCheckInterval = types.Int64Value(int64(account.MinCheckInterval))
+	data.MaxRegionsPerMonitor = types.Int64Value(int64(account.MaxRegionsPerMonitor))
+	data.SMSCreditsRemaining = types.Int64Value(int64(account.SMSCreditsRemaining))
+	data.StatusPagesLimit = types.Int64Value(int64(account.StatusPagesLimit))
+	data.ContactsLimit = types.Int64Value(int64(account.ContactsLimit))
+
+	allowedRegions, diags := types.ListValueFrom(ctx, types.StringType, nonNilStrings(account.AllowedRegions))
+	resp.Diagnostics.Append(diags...)
+	enabledChannels, diags := types.ListValueFrom(ctx, types.StringType, nonNilStrings(account.EnabledChannels))
+	resp.Diagnostics.Append(diags...)
+	enabledMonitorTypes, diags := types.ListValueFrom(ctx, types.StringType, nonNilStrings(account.EnabledMonitorTypes))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.AllowedRegions = allowedRegions
+	data.EnabledChannels = enabledChannels
+	data.EnabledMonitorTypes = enabledMonitorTypes
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+// nonNilStrings returns an empty slice instead of nil so lists are never null
+func nonNilStrings(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
@@ -32,6 +32,9 @@ func TestAccountDataSource_Schema(t *testing.T) {
 	expectedAttributes := []string{
 		"id", "email", "current_plan", "monitors_limit",
 		"monitors_count", "up_monitors", "down_monitors", "paused_monitors",
+		"min_check_interval", "allowed_regions", "max_regions_per_monitor",
+		"sms_credits_remaining", "status_pages_limit", "contacts_limit",
+		"enabled_channels", "enabled_monitor_types",
 	}
 
 	for _, attrName := range expectedAttributes {
@@ -54,6 +57,9 @@ func TestAccountDataSource_Schema(t *testing.T) {
 
 	monitorsCountAttr, _ := attrs["monitors_count"].(schema.Int64Attribute)
 	assert.True(t, monitorsCountAttr.Computed)
+
+	allowedRegionsAttr, _ := attrs["allowed_regions"].(schema.ListAttribute)
+	assert.Equal(t, types.StringType, allowedRegionsAttr.ElementType)
 }
 
 func TestAccountDataSource_Metadata(t *testing.T) {
@@ -0,0 +1,265 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-uptime/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &IncidentsDataSource{}
+
+func NewIncidentsDataSource() datasource.DataSource {
+	return &IncidentsDataSource{}
+}
+
+// IncidentsDataSource defines the data source implementation.
+type IncidentsDataSource struct {
+	client *client.Client
+}
+
+// IncidentsDataSourceModel describes the data source data model.
+type IncidentsDataSourceModel struct {
+	MonitorIDs types.List   `tfsdk:"monitor_ids"`
+	From       types.String `tfsdk:"from"`
+	To         types.String `tfsdk:"to"`
+	Status     types.String `tfsdk:"status"`
+	Incidents  types.List   `tfsdk:"incidents"`
+}
+
+// IncidentModel describes a single incident.
+type IncidentModel struct {
+	ID          types.String `tfsdk:"id"`
+	MonitorID   types.String `tfsdk:"monitor_id"`
+	MonitorName types.String `tfsdk:"monitor_name"`
+	Status      types.String `tfsdk:"status"`
+	StartedAt   types.String `tfsdk:"started_at"`
+	ResolvedAt  types.String `tfsdk:"resolved_at"`
+	Duration    types.Int64  `tfsdk:"duration"`
+	Cause       types.String `tfsdk:"cause"`
+	Regions     types.List   `tfsdk:"regions"`
+}
+
+func (d *IncidentsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_incidents"
+}
+
+func (d *IncidentsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Incidents data source lists open and past incidents, optionally filtered by monitor, time range and status.",
+
+		Attributes: map[string]schema.Attribute{
+			"monitor_ids": schema.ListAttribute{
+				MarkdownDescription: "Only return incidents of these monitors. Defaults to all monitors.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"from": schema.StringAttribute{
+				MarkdownDescription: "Only return incidents that were ongoing at or after this time (RFC 3339, e.g. `2024-01-01T00:00:00Z`)",
+				Optional:            true,
+			},
+			"to": schema.StringAttribute{
+				MarkdownDescription: "Only return incidents that started at or before this time (RFC 3339)",
+				Optional:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Only return incidents with this status: `open` or `resolved`",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("open", "resolved"),
+				},
+			},
+			"incidents": schema.ListNestedAttribute{
+				MarkdownDescription: "Matching incidents, most recent first",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Incident identifier",
+							Computed:            true,
+						},
+						"monitor_id": schema.StringAttribute{
+							MarkdownDescription: "Identifier of the affected monitor",
+							Computed:            true,
+						},
+						"monitor_name": schema.StringAttribute{
+							MarkdownDescription: "Name of the affected monitor",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "Incident status: `open` or `resolved`",
+							Computed:            true,
+						},
+						"started_at": schema.StringAttribute{
+							MarkdownDescription: "When the incident started (RFC 3339)",
+							Computed:            true,
+						},
+						"resolved_at": schema.StringAttribute{
+							MarkdownDescription: "When the incident was resolved (RFC 3339). Null while the incident is open.",
+							Computed:            true,
+						},
+						"duration": schema.Int64Attribute{
+							MarkdownDescription: "Duration of the incident in seconds, so far for open incidents",
+							Computed:            true,
+						},
+						"cause": schema.StringAttribute{
+							MarkdownDescription: "Reported cause, e.g. the error or unexpected status code",
+							Computed:            true,
+						},
+						"regions": schema.ListAttribute{
+							MarkdownDescription: "Regions that observed the failure",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *IncidentsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *IncidentsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data IncidentsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts := client.ListIncidentsOptions{
+		Status: data.Status.ValueString(),
+	}
+
+	if !data.MonitorIDs.IsNull() {
+		resp.Diagnostics.Append(data.MonitorIDs.ElementsAs(ctx, &opts.MonitorIDs, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if !data.From.IsNull() {
+		from, err := time.Parse(time.RFC3339, data.From.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("from"), "Invalid Timestamp", fmt.Sprintf("Expected an RFC 3339 timestamp, got error: %s", err))
+		}
+		opts.From = from.Unix()
+	}
+
+	if !data.To.IsNull() {
+		to, err := time.Parse(time.RFC3339, data.To.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("to"), "Invalid Timestamp", fmt.Sprintf("Expected an RFC 3339 timestamp, got error: %s", err))
+		}
+		opts.To = to.Unix()
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if opts.From > 0 && opts.To > 0 && opts.From > opts.To {
+		resp.Diagnostics.AddAttributeError(path.Root("to"), "Invalid Time Range", "`to` must not be earlier than `from`")
+		return
+	}
+
+	// List incidents from API
+	incidents, err := d.client.ListIncidents(opts)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list incidents, got error: %s", err))
+		return
+	}
+
+	incidentModels := make([]IncidentModel, 0, len(incidents))
+	for _, incident := range incidents {
+		regionList, diags := types.ListValueFrom(ctx, types.StringType, incident.Regions)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		model := IncidentModel{
+			ID:          types.StringValue(incident.ID),
+			MonitorID:   types.StringValue(incident.MonitorID),
+			MonitorName: types.StringNull(),
+			Status:      types.StringValue(incident.Status),
+			StartedAt:   types.StringValue(formatUnixTime(incident.StartedAt)),
+			ResolvedAt:  types.StringNull(),
+			Duration:    types.Int64Value(incident.Duration),
+			Cause:       types.StringNull(),
+			Regions:     regionList,
+		}
+
+		if incident.MonitorName != "" {
+			model.MonitorName = types.StringValue(incident.MonitorName)
+		}
+		if incident.ResolvedAt > 0 {
+			model.ResolvedAt = types.StringValue(formatUnixTime(incident.ResolvedAt))
+		}
+		if incident.Cause != "" {
+			model.Cause = types.StringValue(incident.Cause)
+		}
+
+		incidentModels = append(incidentModels, model)
+	}
+
+	incidentList, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: incidentAttrTypes()}, incidentModels)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Incidents = incidentList
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// formatUnixTime formats a Unix timestamp as an RFC 3339 string in UTC
+func formatUnixTime(ts int64) string {
+	return time.Unix(ts, 0).UTC().Format(time.RFC3339)
+}
+
+// incidentAttrTypes returns the attribute types of an incidents list element
+func incidentAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":           types.StringType,
+		"monitor_id":   types.StringType,
+		"monitor_name": types.StringType,
+		"status":       types.StringType,
+		"started_at":   types.StringType,
+		"resolved_at":  types.StringType,
+		"duration":     types.Int64Type,
+		"cause":        types.StringType,
+		"regions":      types.ListType{ElemType: types.StringType},
+	}
+}
@@ -0,0 +1,269 @@
+package datasources
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-uptime/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &IPRangesDataSource{}
+
+func NewIPRangesDataSource() datasource.DataSource {
+	return &IPRangesDataSource{}
+}
+
+// IPRangesDataSource defines the data source implementation.
+type IPRangesDataSource struct {
+	client *client.Client
+}
+
+// IPRangesDataSourceModel describes the data source data model.
+type IPRangesDataSourceModel struct {
+	Regions        types.List   `tfsdk:"regions"`
+	IPv4CIDRBlocks types.List   `tfsdk:"ipv4_cidr_blocks"`
+	IPv6CIDRBlocks types.List   `tfsdk:"ipv6_cidr_blocks"`
+	Ranges         types.List   `tfsdk:"ranges"`
+	Hash           types.String `tfsdk:"hash"`
+}
+
+// IPRangeModel describes the CIDR blocks of a single region.
+type IPRangeModel struct {
+	Region         types.String `tfsdk:"region"`
+	IPv4CIDRBlocks types.List   `tfsdk:"ipv4_cidr_blocks"`
+	IPv6CIDRBlocks types.List   `tfsdk:"ipv6_cidr_blocks"`
+}
+
+func (d *IPRangesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ip_ranges"
+}
+
+func (d *IPRangesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "IP ranges data source returns the egress CIDR blocks used by checkers, for allowlisting in firewalls and security groups.",
+
+		Attributes: map[string]schema.Attribute{
+			"regions": schema.ListAttribute{
+				MarkdownDescription: "Only return ranges for these regions, e.g. the `regions` of a monitor. Defaults to all regions.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"ipv4_cidr_blocks": schema.ListAttribute{
+				MarkdownDescription: "Sorted, de-duplicated IPv4 CIDR blocks across the selected regions",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"ipv6_cidr_blocks": schema.ListAttribute{
+				MarkdownDescription: "Sorted, de-duplicated IPv6 CIDR blocks across the selected regions",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"ranges": schema.ListNestedAttribute{
+				MarkdownDescription: "CIDR blocks per region",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"region": schema.StringAttribute{
+							MarkdownDescription: "Region identifier",
+							Computed:            true,
+						},
+						"ipv4_cidr_blocks": schema.ListAttribute{
+							MarkdownDescription: "IPv4 CIDR blocks used by checkers in the region",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+						"ipv6_cidr_blocks": schema.ListAttribute{
+							MarkdownDescription: "IPv6 CIDR blocks used by checkers in the region",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"hash": schema.StringAttribute{
+				MarkdownDescription: "SHA-256 hash of the selected CIDR blocks. Changes whenever checker addresses are added or removed.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *IPRangesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *IPRangesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data IPRangesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var regions []string
+	if !data.Regions.IsNull() {
+		resp.Diagnostics.Append(data.Regions.ElementsAs(ctx, &regions, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Get IP ranges from API
+	ranges, err := d.client.ListIPRanges()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list IP ranges, got error: %s", err))
+		return
+	}
+
+	selected, missing := filterIPRanges(ranges, regions)
+	if len(missing) > 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("regions"),
+			"Unknown Regions",
+			fmt.Sprintf("No IP ranges were found for regions: %s", strings.Join(missing, ", ")),
+		)
+		return
+	}
+
+	var allIPv4, allIPv6 []string
+	rangeModels := make([]IPRangeModel, 0, len(selected))
+	for _, r := range selected {
+		ipv4 := normalizeCIDRs(r.IPv4, "/32")
+		ipv6 := normalizeCIDRs(r.IPv6, "/128")
+		allIPv4 = append(allIPv4, ipv4...)
+		allIPv6 = append(allIPv6, ipv6...)
+
+		ipv4List, diags := types.ListValueFrom(ctx, types.StringType, ipv4)
+		resp.Diagnostics.Append(diags...)
+		ipv6List, diags := types.ListValueFrom(ctx, types.StringType, ipv6)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		rangeModels = append(rangeModels, IPRangeModel{
+			Region:         types.StringValue(r.Region),
+			IPv4CIDRBlocks: ipv4List,
+			IPv6CIDRBlocks: ipv6List,
+		})
+	}
+
+	allIPv4 = normalizeCIDRs(allIPv4, "/32")
+	allIPv6 = normalizeCIDRs(allIPv6, "/128")
+
+	ipv4List, diags := types.ListValueFrom(ctx, types.StringType, allIPv4)
+	resp.Diagnostics.Append(diags...)
+	ipv6List, diags := types.ListValueFrom(ctx, types.StringType, allIPv6)
+	resp.Diagnostics.Append(diags...)
+	rangeList, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: ipRangeAttrTypes()}, rangeModels)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.IPv4CIDRBlocks = ipv4List
+	data.IPv6CIDRBlocks = ipv6List
+	data.Ranges = rangeList
+
+	data.Hash = types.StringValue(hashCIDRs(allIPv4, allIPv6))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// filterIPRanges returns the ranges for the requested regions (all ranges when
+// none are requested), sorted by region, together with any requested regions
+// that have no ranges
+func filterIPRanges(ranges []client.IPRange, regions []string) ([]client.IPRange, []string) {
+	var selected []client.IPRange
+	var missing []string
+
+	if len(regions) == 0 {
+		selected = append(selected, ranges...)
+	} else {
+		byRegion := make(map[string]client.IPRange, len(ranges))
+		for _, r := range ranges {
+			byRegion[r.Region] = r
+		}
+		seen := make(map[string]bool, len(regions))
+		for _, region := range regions {
+			if seen[region] {
+				continue
+			}
+			seen[region] = true
+			if r, ok := byRegion[region]; ok {
+				selected = append(selected, r)
+			} else {
+				missing = append(missing, region)
+			}
+		}
+	}
+
+	sort.Slice(selected, func(i, j int) bool { return selected[i].Region < selected[j].Region })
+	return selected, missing
+}
+
+// normalizeCIDRs turns bare addresses into host CIDRs using the given suffix
+// and returns the blocks sorted and de-duplicated
+func normalizeCIDRs(blocks []string, hostSuffix string) []string {
+	seen := make(map[string]bool, len(blocks))
+	result := make([]string, 0, len(blocks))
+	for _, block := range blocks {
+		block = strings.TrimSpace(block)
+		if block == "" {
+			continue
+		}
+		if !strings.Contains(block, "/") {
+			block += hostSuffix
+		}
+		if !seen[block] {
+			seen[block] = true
+			result = append(result, block)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+// hashCIDRs returns a stable SHA-256 hash over the IPv4 and IPv6 blocks
+func hashCIDRs(ipv4, ipv6 []string) string {
+	h := sha256.New()
+	h.Write([]byte("ipv4\n" + strings.Join(ipv4, "\n") + "\nipv6\n" + strings.Join(ipv6, "\n")))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// ipRangeAttrTypes returns the attribute types of a ranges list element
+func ipRangeAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"region":           types.StringType,
+		"ipv4_cidr_blocks": types.ListType{ElemType: types.StringType},
+		"ipv6_cidr_blocks": types.ListType{ElemType: types.StringType},
+	}
+}
@@ -0,0 +1,57 @@
+package datasources
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"terraform-provider-uptime/internal/client"
+)
+
+func TestFilterIPRanges(t *testing.T) {
+	ranges := []client.IPRange{
+		{Region: "us-east-1", IPv4: []string{"203.0.113.10"}},
+		{Region: "eu-west-1", IPv4: []string{"198.51.100.0/28"}},
+		{Region: "ap-south-1", IPv6: []string{"2001:db8::1"}},
+	}
+
+	// No filter returns every region, sorted
+	selected, missing := filterIPRanges(ranges, nil)
+	assert.Empty(t, missing)
+	assert.Equal(t, []string{"ap-south-1", "eu-west-1", "us-east-1"}, regionIDs(selected))
+
+	// Filtering keeps only requested regions and ignores duplicates
+	selected, missing = filterIPRanges(ranges, []string{"us-east-1", "eu-west-1", "us-east-1"})
+	assert.Empty(t, missing)
+	assert.Equal(t, []string{"eu-west-1", "us-east-1"}, regionIDs(selected))
+
+	// Unknown regions are reported
+	_, missing = filterIPRanges(ranges, []string{"us-east-1", "mars-1"})
+	assert.Equal(t, []string{"mars-1"}, missing)
+}
+
+func TestNormalizeCIDRs(t *testing.T) {
+	blocks := normalizeCIDRs([]string{"203.0.113.10", "198.51.100.0/28", " 203.0.113.10/32 ", ""}, "/32")
+	assert.Equal(t, []string{"198.51.100.0/28", "203.0.113.10/32"}, blocks)
+
+	blocks = normalizeCIDRs([]string{"2001:db8::1"}, "/128")
+	assert.Equal(t, []string{"2001:db8::1/128"}, blocks)
+}
+
+func TestHashCIDRs(t *testing.T) {
+	hash := hashCIDRs([]string{"198.51.100.0/28"}, nil)
+
+	// Hash is stable for identical input
+	assert.Equal(t, hash, hashCIDRs([]string{"198.51.100.0/28"}, nil))
+
+	// Hash changes when a block is added or moves between families
+	assert.NotEqual(t, hash, hashCIDRs([]string{"198.51.100.0/28", "203.0.113.10/32"}, nil))
+	assert.NotEqual(t, hash, hashCIDRs(nil, []string{"198.51.100.0/28"}))
+}
+
+func regionIDs(ranges []client.IPRange) []string {
+	ids := make([]string, len(ranges))
+	for i, r := range ranges {
+		ids[i] = r.Region
+	}
+	return ids
+}
@@ -33,6 +33,7 @@ type MonitorDataSourceModel struct {
 	Regions       types.List   `tfsdk:"regions"`
 	CreatedAt     types.String `tfsdk:"created_at"`
 	UpdatedAt     types.String `tfsdk:"updated_at"`
+	LastStatus    types.String `tfsdk:"last_status"`
 }
 
 func (d *MonitorDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -81,6 +82,10 @@ func (d *MonitorDataSource) Schema(ctx context.Context, req datasource.SchemaReq
 				MarkdownDescription: "When the monitor was last updated",
 				Computed:            true,
 			},
+			"last_status": schema.StringAttribute{
+				MarkdownDescription: "Status reported by the most recent check, e.g. `up` or `down`",
+				Computed:            true,
+			},
 		},
 	}
 }
@@ -171,6 +176,12 @@ func (d *MonitorDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		data.UpdatedAt = types.StringNull()
 	}
 
+	if monitor.LastStatus != "" {
+		data.LastStatus = types.StringValue(monitor.LastStatus)
+	} else {
+		data.LastStatus = types.StringNull()
+	}
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -0,0 +1,343 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-uptime/internal/client"
+)
+
+// defaultStatsWindow is the window used when none is configured
+const defaultStatsWindow = "24h"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &MonitorStatsDataSource{}
+
+func NewMonitorStatsDataSource() datasource.DataSource {
+	return &MonitorStatsDataSource{}
+}
+
+// MonitorStatsDataSource defines the data source implementation.
+type MonitorStatsDataSource struct {
+	client *client.Client
+}
+
+// MonitorStatsDataSourceModel describes the data source data model.
+type MonitorStatsDataSourceModel struct {
+	MonitorID         types.String  `tfsdk:"monitor_id"`
+	Window            types.String  `tfsdk:"window"`
+	RecentChecksLimit types.Int64   `tfsdk:"recent_checks_limit"`
+	LastStatus        types.String  `tfsdk:"last_status"`
+	UptimePercentage  types.Float64 `tfsdk:"uptime_percentage"`
+	AvgResponseTime   types.Float64 `tfsdk:"avg_response_time"`
+	P95ResponseTime   types.Float64 `tfsdk:"p95_response_time"`
+	IncidentCount     types.Int64   `tfsdk:"incident_count"`
+	TotalChecks       types.Int64   `tfsdk:"total_checks"`
+	FailedChecks      types.Int64   `tfsdk:"failed_checks"`
+	Regions           types.List    `tfsdk:"regions"`
+	RecentChecks      types.List    `tfsdk:"recent_checks"`
+}
+
+// RegionStatsModel describes the statistics of a monitor in a single region.
+type RegionStatsModel struct {
+	Region           types.String  `tfsdk:"region"`
+	UptimePercentage types.Float64 `tfsdk:"uptime_percentage"`
+	AvgResponseTime  types.Float64 `tfsdk:"avg_response_time"`
+	P95ResponseTime  types.Float64 `tfsdk:"p95_response_time"`
+	TotalChecks      types.Int64   `tfsdk:"total_checks"`
+	FailedChecks     types.Int64   `tfsdk:"failed_checks"`
+}
+
+// CheckResultModel describes a single check result.
+type CheckResultModel struct {
+	CheckedAt    types.Int64  `tfsdk:"checked_at"`
+	Region       types.String `tfsdk:"region"`
+	Status       types.String `tfsdk:"status"`
+	ResponseTime types.Int64  `tfsdk:"response_time"`
+	StatusCode   types.Int64  `tfsdk:"status_code"`
+	Error        types.String `tfsdk:"error"`
+}
+
+func (d *MonitorStatsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_monitor_stats"
+}
+
+func (d *MonitorStatsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Monitor stats data source returns uptime and response time statistics of a monitor over a time window. Useful together with `check` blocks to gate releases on availability.",
+
+		Attributes: map[string]schema.Attribute{
+			"monitor_id": schema.StringAttribute{
+				MarkdownDescription: "Monitor identifier",
+				Required:            true,
+			},
+			"window": schema.StringAttribute{
+				MarkdownDescription: "Time window the statistics are aggregated over: `24h`, `7d`, `30d` or `90d`. Defaults to `24h`.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("24h", "7d", "30d", "90d"),
+				},
+			},
+			"recent_checks_limit": schema.Int64Attribute{
+				MarkdownDescription: "Number of most recent check results to return in `recent_checks` (1-100). No check results are fetched when unset.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 100),
+				},
+			},
+			"last_status": schema.StringAttribute{
+				MarkdownDescription: "Status reported by the most recent check, e.g. `up` or `down`",
+				Computed:            true,
+			},
+			"uptime_percentage": schema.Float64Attribute{
+				MarkdownDescription: "Percentage of successful checks in the window",
+				Computed:            true,
+			},
+			"avg_response_time": schema.Float64Attribute{
+				MarkdownDescription: "Average response time in milliseconds",
+				Computed:            true,
+			},
+			"p95_response_time": schema.Float64Attribute{
+				MarkdownDescription: "95th percentile response time in milliseconds",
+				Computed:            true,
+			},
+			"incident_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of incidents opened in the window",
+				Computed:            true,
+			},
+			"total_checks": schema.Int64Attribute{
+				MarkdownDescription: "Number of checks performed in the window",
+				Computed:            true,
+			},
+			"failed_checks": schema.Int64Attribute{
+				MarkdownDescription: "Number of failed checks in the window",
+				Computed:            true,
+			},
+			"regions": schema.ListNestedAttribute{
+				MarkdownDescription: "Statistics per check region",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"region": schema.StringAttribute{
+							MarkdownDescription: "Region identifier",
+							Computed:            true,
+						},
+						"uptime_percentage": schema.Float64Attribute{
+							MarkdownDescription: "Percentage of successful checks from the region",
+							Computed:            true,
+						},
+						"avg_response_time": schema.Float64Attribute{
+							MarkdownDescription: "Average response time in milliseconds",
+							Computed:            true,
+						},
+						"p95_response_time": schema.Float64Attribute{
+							MarkdownDescription: "95th percentile response time in milliseconds",
+							Computed:            true,
+						},
+						"total_checks": schema.Int64Attribute{
+							MarkdownDescription: "Number of checks performed from the region",
+							Computed:            true,
+						},
+						"failed_checks": schema.Int64Attribute{
+							MarkdownDescription: "Number of failed checks from the region",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"recent_checks": schema.ListNestedAttribute{
+				MarkdownDescription: "Most recent check results, newest first. Only populated when `recent_checks_limit` is set.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"checked_at": schema.Int64Attribute{
+							MarkdownDescription: "Unix timestamp of the check",
+							Computed:            true,
+						},
+						"region": schema.StringAttribute{
+							MarkdownDescription: "Region the check ran from",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "Outcome of the check, e.g. `up` or `down`",
+							Computed:            true,
+						},
+						"response_time": schema.Int64Attribute{
+							MarkdownDescription: "Response time in milliseconds",
+							Computed:            true,
+						},
+						"status_code": schema.Int64Attribute{
+							MarkdownDescription: "HTTP status code returned, for HTTPS monitors",
+							Computed:            true,
+						},
+						"error": schema.StringAttribute{
+							MarkdownDescription: "Error reported by the check, if it failed",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *MonitorStatsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *MonitorStatsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data MonitorStatsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Window.IsNull() || data.Window.IsUnknown() {
+		data.Window = types.StringValue(defaultStatsWindow)
+	}
+
+	monitorID := data.MonitorID.ValueString()
+
+	// Get monitor from API for its last status
+	monitor, err := d.client.GetMonitor(monitorID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read monitor: %s", err))
+		return
+	}
+
+	if monitor == nil {
+		resp.Diagnostics.AddError("Monitor Not Found", fmt.Sprintf("Monitor with ID %s was not found", monitorID))
+		return
+	}
+
+	if monitor.LastStatus != "" {
+		data.LastStatus = types.StringValue(monitor.LastStatus)
+	} else {
+		data.LastStatus = types.StringNull()
+	}
+
+	// Get statistics from API
+	stats, err := d.client.GetMonitorStats(monitorID, data.Window.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read monitor stats, got error: %s", err))
+		return
+	}
+
+	data.UptimePercentage = types.Float64Value(stats.UptimePercentage)
+	data.AvgResponseTime = types.Float64Value(stats.AvgResponseTime)
+	data.P95ResponseTime = types.Float64Value(stats.P95ResponseTime)
+	data.IncidentCount = types.Int64Value(int64(stats.IncidentCount))
+	data.TotalChecks = types.Int64Value(int64(stats.TotalChecks))
+	data.FailedChecks = types.Int64Value(int64(stats.FailedChecks))
+
+	regionModels := make([]RegionStatsModel, len(stats.Regions))
+	for i, region := range stats.Regions {
+		regionModels[i] = RegionStatsModel{
+			Region:           types.StringValue(region.Region),
+			UptimePercentage: types.Float64Value(region.UptimePercentage),
+			AvgResponseTime:  types.Float64Value(region.AvgResponseTime),
+			P95ResponseTime:  types.Float64Value(region.P95ResponseTime),
+			TotalChecks:      types.Int64Value(int64(region.TotalChecks)),
+			FailedChecks:     types.Int64Value(int64(region.FailedChecks)),
+		}
+	}
+
+	regionList, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: regionStatsAttrTypes()}, regionModels)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Regions = regionList
+
+	// Get recent check results from API when requested
+	if data.RecentChecksLimit.IsNull() {
+		data.RecentChecks = types.ListNull(types.ObjectType{AttrTypes: checkResultAttrTypes()})
+	} else {
+		checks, err := d.client.ListMonitorCheckResults(monitorID, client.CheckResultsOptions{
+			Limit: int(data.RecentChecksLimit.ValueInt64()),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list check results, got error: %s", err))
+			return
+		}
+
+		checkModels := make([]CheckResultModel, len(checks))
+		for i, check := range checks {
+			checkModels[i] = CheckResultModel{
+				CheckedAt:    types.Int64Value(check.CheckedAt),
+				Region:       types.StringValue(check.Region),
+				Status:       types.StringValue(check.Status),
+				ResponseTime: types.Int64Value(int64(check.ResponseTime)),
+				StatusCode:   types.Int64Null(),
+				Error:        types.StringNull(),
+			}
+			if check.StatusCode > 0 {
+				checkModels[i].StatusCode = types.Int64Value(int64(check.StatusCode))
+			}
+			if check.Error != nil {
+				checkModels[i].Error = types.StringValue(*check.Error)
+			}
+		}
+
+		checkList, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: checkResultAttrTypes()}, checkModels)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		data.RecentChecks = checkList
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// regionStatsAttrTypes returns the attribute types of a regions list element
+func regionStatsAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"region":            types.StringType,
+		"uptime_percentage": types.Float64Type,
+		"avg_response_time": types.Float64Type,
+		"p95_response_time": types.Float64Type,
+		"total_checks":      types.Int64Type,
+		"failed_checks":     types.Int64Type,
+	}
+}
+
+// checkResultAttrTypes returns the attribute types of a recent_checks list element
+func checkResultAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"checked_at":    types.Int64Type,
+		"region":        types.StringType,
+		"status":        types.StringType,
+		"response_time": types.Int64Type,
+		"status_code":   types.Int64Type,
+		"error":         types.StringType,
+	}
+}
@@ -0,0 +1,156 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-uptime/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &RegionsDataSource{}
+
+func NewRegionsDataSource() datasource.DataSource {
+	return &RegionsDataSource{}
+}
+
+// RegionsDataSource defines the data source implementation.
+type RegionsDataSource struct {
+	client *client.Client
+}
+
+// RegionsDataSourceModel describes the data source data model.
+type RegionsDataSourceModel struct {
+	Regions types.List `tfsdk:"regions"`
+}
+
+// RegionModel describes a single check region.
+type RegionModel struct {
+	ID        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	Continent types.String `tfsdk:"continent"`
+	IPv4      types.Bool   `tfsdk:"ipv4"`
+	IPv6      types.Bool   `tfsdk:"ipv6"`
+	Available types.Bool   `tfsdk:"available"`
+}
+
+func (d *RegionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_regions"
+}
+
+func (d *RegionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Regions data source lists the check regions monitors can run from.",
+
+		Attributes: map[string]schema.Attribute{
+			"regions": schema.ListNestedAttribute{
+				MarkdownDescription: "All check regions known to the service",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Region identifier, as used in `uptime_monitor.regions`",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Display name of the region",
+							Computed:            true,
+						},
+						"continent": schema.StringAttribute{
+							MarkdownDescription: "Continent the region is located on",
+							Computed:            true,
+						},
+						"ipv4": schema.BoolAttribute{
+							MarkdownDescription: "Whether checks from this region can target IPv4 endpoints",
+							Computed:            true,
+						},
+						"ipv6": schema.BoolAttribute{
+							MarkdownDescription: "Whether checks from this region can target IPv6 endpoints",
+							Computed:            true,
+						},
+						"available": schema.BoolAttribute{
+							MarkdownDescription: "Whether the region can be used on the current plan",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *RegionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *RegionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data RegionsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get regions from API
+	regions, err := d.client.ListRegionsCached()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list regions, got error: %s", err))
+		return
+	}
+
+	regionModels := make([]RegionModel, len(regions))
+	for i, region := range regions {
+		regionModels[i] = RegionModel{
+			ID:        types.StringValue(region.ID),
+			Name:      types.StringValue(region.Name),
+			Continent: types.StringValue(region.Continent),
+			IPv4:      types.BoolValue(region.IPv4),
+			IPv6:      types.BoolValue(region.IPv6),
+			Available: types.BoolValue(region.Available),
+		}
+	}
+
+	regionList, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: regionAttrTypes()}, regionModels)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Regions = regionList
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// regionAttrTypes returns the attribute types of a region list element
+func regionAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":        types.StringType,
+		"name":      types.StringType,
+		"continent": types.StringType,
+		"ipv4":      types.BoolType,
+		"ipv6":      types.BoolType,
+		"available": types.BoolType,
+	}
+}
@@ -3,15 +3,19 @@ package datasources
 import (
 	"context"
 	"fmt"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"terraform-provider-uptime/internal/client"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ datasource.DataSource = &StatusPageDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &StatusPageDataSource{}
 
 func NewStatusPageDataSource() datasource.DataSource {
 	return &StatusPageDataSource{}
@@ -41,16 +45,18 @@ func (d *StatusPageDataSource) Metadata(ctx context.Context, req datasource.Meta
 
 func (d *StatusPageDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Status page data source for reading existing status pages",
+		MarkdownDescription: "Status page data source for reading existing status pages. Exactly one of `id`, `name` or `custom_domain` must be set.",
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
-				Required:            true,
+				Optional:            true,
+				Computed:            true,
 				MarkdownDescription: "The unique identifier of the status page to read",
 			},
 			"name": schema.StringAttribute{
+				Optional:            true,
 				Computed:            true,
-				MarkdownDescription: "Display name for the status page",
+				MarkdownDescription: "Display name for the status page. When set, the status page is looked up by name, which must be unique within the account.",
 			},
 			"monitors": schema.ListAttribute{
 				Computed:            true,
@@ -62,8 +68,9 @@ func (d *StatusPageDataSource) Schema(ctx context.Context, req datasource.Schema
 				MarkdownDescription: "Time period in days for uptime statistics",
 			},
 			"custom_domain": schema.StringAttribute{
+				Optional:            true,
 				Computed:            true,
-				MarkdownDescription: "Custom domain for accessing the status page",
+				MarkdownDescription: "Custom domain for accessing the status page. When set, the status page is looked up by its custom domain.",
 			},
 			"show_incident_reasons": schema.BoolAttribute{
 				Computed:            true,
@@ -86,6 +93,16 @@ func (d *StatusPageDataSource) Schema(ctx context.Context, req datasource.Schema
 	}
 }
 
+func (d *StatusPageDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+			path.MatchRoot("custom_domain"),
+		),
+	}
+}
+
 func (d *StatusPageDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	// Prevent panic if the provider is not configured.
 	if req.ProviderData == nil {
@@ -116,14 +133,68 @@ func (d *StatusPageDataSource) Read(ctx context.Context, req datasource.ReadRequ
 		return
 	}
 
-	// Get status page from API
-	statusPage, err := d.client.GetStatusPage(data.ID.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read status page, got error: %s", err))
-		return
+	var statusPage *client.StatusPage
+
+	if !data.ID.IsNull() {
+		// Get status page from API
+		var err error
+		statusPage, err = d.client.GetStatusPage(data.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read status page, got error: %s", err))
+			return
+		}
+	} else {
+		// Look up the status page by name or custom domain
+		statusPages, err := d.client.ListStatusPages()
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list status pages, got error: %s", err))
+			return
+		}
+
+		var lookupAttr, lookupValue string
+		var matches []client.StatusPage
+		if !data.Name.IsNull() {
+			lookupAttr, lookupValue = "name", data.Name.ValueString()
+			for _, sp := range statusPages {
+				if sp.Name == lookupValue {
+					matches = append(matches, sp)
+				}
+			}
+		} else {
+			lookupAttr, lookupValue = "custom_domain", data.CustomDomain.ValueString()
+			for _, sp := range statusPages {
+				if sp.CustomDomain != nil && strings.EqualFold(*sp.CustomDomain, lookupValue) {
+					matches = append(matches, sp)
+				}
+			}
+		}
+
+		switch len(matches) {
+		case 0:
+			resp.Diagnostics.AddAttributeError(
+				path.Root(lookupAttr),
+				"Status Page Not Found",
+				fmt.Sprintf("No status page with %s %q was found", lookupAttr, lookupValue),
+			)
+			return
+		case 1:
+			statusPage = &matches[0]
+		default:
+			ids := make([]string, len(matches))
+			for i, sp := range matches {
+				ids[i] = sp.ID
+			}
+			resp.Diagnostics.AddAttributeError(
+				path.Root(lookupAttr),
+				"Multiple Status Pages Found",
+				fmt.Sprintf("Found %d status pages with %s %q (IDs: %s). Use id to select one.", len(matches), lookupAttr, lookupValue, strings.Join(ids, ", ")),
+			)
+			return
+		}
 	}
 
 	// Update model with API data
+	data.ID = types.StringValue(statusPage.ID)
 	data.Name = types.StringValue(statusPage.Name)
 	data.Period = types.Int64Value(int64(statusPage.Period))
 	data.ShowIncidentReasons = types.BoolValue(statusPage.ShowIncidentReasons)
@@ -0,0 +1,183 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-uptime/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &StatusPagesDataSource{}
+
+func NewStatusPagesDataSource() datasource.DataSource {
+	return &StatusPagesDataSource{}
+}
+
+// StatusPagesDataSource defines the data source implementation.
+type StatusPagesDataSource struct {
+	client *client.Client
+}
+
+// StatusPagesDataSourceModel describes the data source data model.
+type StatusPagesDataSourceModel struct {
+	StatusPages types.List `tfsdk:"status_pages"`
+}
+
+// StatusPageSummaryModel describes a single status page in the list.
+type StatusPageSummaryModel struct {
+	ID                  types.String `tfsdk:"id"`
+	Name                types.String `tfsdk:"name"`
+	Monitors            types.List   `tfsdk:"monitors"`
+	Period              types.Int64  `tfsdk:"period"`
+	CustomDomain        types.String `tfsdk:"custom_domain"`
+	ShowIncidentReasons types.Bool   `tfsdk:"show_incident_reasons"`
+	CreatedAt           types.Int64  `tfsdk:"created_at"`
+	URL                 types.String `tfsdk:"url"`
+}
+
+func (d *StatusPagesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_status_pages"
+}
+
+func (d *StatusPagesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Status pages data source for listing every status page in the account",
+
+		Attributes: map[string]schema.Attribute{
+			"status_pages": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "All status pages in the account",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The unique identifier of the status page",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Display name for the status page",
+						},
+						"monitors": schema.ListAttribute{
+							Computed:            true,
+							ElementType:         types.StringType,
+							MarkdownDescription: "List of monitor IDs displayed on the status page",
+						},
+						"period": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Time period in days for uptime statistics",
+						},
+						"custom_domain": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Custom domain for accessing the status page",
+						},
+						"show_incident_reasons": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether incident reasons are shown publicly",
+						},
+						"created_at": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Unix timestamp when the status page was created",
+						},
+						"url": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The URL where the status page can be accessed",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *StatusPagesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider is not configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *StatusPagesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data StatusPagesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// List all status pages from API
+	statusPages, err := d.client.ListStatusPages()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list status pages, got error: %s", err))
+		return
+	}
+
+	summaries := make([]StatusPageSummaryModel, 0, len(statusPages))
+	for _, statusPage := range statusPages {
+		monitorList, diags := types.ListValueFrom(ctx, types.StringType, statusPage.Monitors)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		summary := StatusPageSummaryModel{
+			ID:                  types.StringValue(statusPage.ID),
+			Name:                types.StringValue(statusPage.Name),
+			Monitors:            monitorList,
+			Period:              types.Int64Value(int64(statusPage.Period)),
+			ShowIncidentReasons: types.BoolValue(statusPage.ShowIncidentReasons),
+			CreatedAt:           types.Int64Value(statusPage.CreatedAt),
+			URL:                 types.StringValue(statusPage.URL),
+			CustomDomain:        types.StringNull(),
+		}
+
+		if statusPage.CustomDomain != nil {
+			summary.CustomDomain = types.StringValue(*statusPage.CustomDomain)
+		}
+
+		summaries = append(summaries, summary)
+	}
+
+	statusPageList, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: statusPageSummaryAttrTypes()}, summaries)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.StatusPages = statusPageList
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// statusPageSummaryAttrTypes returns the attribute types of a status page list element
+func statusPageSummaryAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":                    types.StringType,
+		"name":                  types.StringType,
+		"monitors":              types.ListType{ElemType: types.StringType},
+		"period":                types.Int64Type,
+		"custom_domain":         types.StringType,
+		"show_incident_reasons": types.BoolType,
+		"created_at":            types.Int64Type,
+		"url":                   types.StringType,
+	}
+}
@@ -0,0 +1,113 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &ExpandStatusCodesFunction{}
+
+func NewExpandStatusCodesFunction() function.Function {
+	return &ExpandStatusCodesFunction{}
+}
+
+// ExpandStatusCodesFunction defines the function implementation.
+type ExpandStatusCodesFunction struct{}
+
+func (f *ExpandStatusCodesFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "expand_status_codes"
+}
+
+func (f *ExpandStatusCodesFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Expand an expected status codes expression",
+		MarkdownDescription: "Expands an `expected_status_codes` expression such as `\"200-204,301\"` into the list of status codes it matches, in order and without duplicates.",
+
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "status_codes",
+				MarkdownDescription: "Comma separated status codes and ranges, e.g. `200-204,301`",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.Int64Type,
+		},
+	}
+}
+
+func (f *ExpandStatusCodesFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var expr string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &expr))
+	if resp.Error != nil {
+		return
+	}
+
+	codes, err := expandStatusCodes(expr)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, codes))
+}
+
+// expandStatusCodes parses a comma separated list of status codes and
+// inclusive ranges into the codes it matches
+func expandStatusCodes(expr string) ([]int64, error) {
+	codes := []int64{}
+	seen := make(map[int64]bool)
+
+	for _, part := range strings.Split(expr, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		startStr, endStr, isRange := strings.Cut(part, "-")
+		start, err := parseStatusCode(startStr)
+		if err != nil {
+			return nil, err
+		}
+		end := start
+		if isRange {
+			if end, err = parseStatusCode(endStr); err != nil {
+				return nil, err
+			}
+			if end < start {
+				return nil, fmt.Errorf("invalid status code range %q: start is greater than end", part)
+			}
+		}
+
+		for code := start; code <= end; code++ {
+			if !seen[code] {
+				seen[code] = true
+				codes = append(codes, code)
+			}
+		}
+	}
+
+	if len(codes) == 0 {
+		return nil, fmt.Errorf("no status codes in %q", expr)
+	}
+
+	return codes, nil
+}
+
+// parseStatusCode parses a single HTTP status code
+func parseStatusCode(s string) (int64, error) {
+	code, err := strconv.ParseInt(strings.TrimSpace(s), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid status code %q", strings.TrimSpace(s))
+	}
+	if code < 100 || code > 599 {
+		return 0, fmt.Errorf("status code %d is outside the range 100-599", code)
+	}
+	return code, nil
+}
@@ -0,0 +1,50 @@
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-uptime/internal/resources"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &FormatHeadersFunction{}
+
+func NewFormatHeadersFunction() function.Function {
+	return &FormatHeadersFunction{}
+}
+
+// FormatHeadersFunction defines the function implementation.
+type FormatHeadersFunction struct{}
+
+func (f *FormatHeadersFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "format_headers"
+}
+
+func (f *FormatHeadersFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Format headers in the API wire format",
+		MarkdownDescription: "Formats a map of headers as the newline separated `Name: value` string sent to the API for `request_headers` and `expected_response_headers`, sorted by header name.",
+
+		Parameters: []function.Parameter{
+			function.MapParameter{
+				Name:                "headers",
+				MarkdownDescription: "Map of header names to values",
+				ElementType:         types.StringType,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *FormatHeadersFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var headers map[string]string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &headers))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, resources.FormatHeaders(headers)))
+}
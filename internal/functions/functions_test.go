@@ -0,0 +1,97 @@
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpandStatusCodes(t *testing.T) {
+	tests := []struct {
+		name    string
+		expr    string
+		want    []int64
+		wantErr bool
+	}{
+		{name: "single code", expr: "200", want: []int64{200}},
+		{name: "range and code", expr: "200-204,301", want: []int64{200, 201, 202, 203, 204, 301}},
+		{name: "whitespace and duplicates", expr: " 200 , 200-201 ", want: []int64{200, 201}},
+		{name: "reversed range", expr: "204-200", wantErr: true},
+		{name: "not a number", expr: "2xx", wantErr: true},
+		{name: "out of range", expr: "99", wantErr: true},
+		{name: "empty", expr: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := expandStatusCodes(tt.expr)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestTCPURLFunction_Run(t *testing.T) {
+	tests := []struct {
+		name    string
+		host    string
+		port    int64
+		want    string
+		wantErr bool
+	}{
+		{name: "hostname", host: "db.example.com", port: 5432, want: "tcp://db.example.com:5432"},
+		{name: "ipv6", host: "2001:db8::1", port: 443, want: "tcp://[2001:db8::1]:443"},
+		{name: "invalid port", host: "db.example.com", port: 70000, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(tt.host), types.Int64Value(tt.port)}),
+			}
+			resp := &function.RunResponse{
+				Result: function.NewResultData(types.StringUnknown()),
+			}
+
+			NewTCPURLFunction().Run(context.Background(), req, resp)
+
+			if tt.wantErr {
+				assert.NotNil(t, resp.Error)
+				return
+			}
+			require.Nil(t, resp.Error)
+			assert.Equal(t, types.StringValue(tt.want), resp.Result.Value())
+		})
+	}
+}
+
+func TestHeadersFunctions_RoundTrip(t *testing.T) {
+	ctx := context.Background()
+	headers, _ := types.MapValueFrom(ctx, types.StringType, map[string]string{
+		"X-Api-Key": "secret",
+		"Accept":    "application/json",
+	})
+
+	formatResp := &function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+	NewFormatHeadersFunction().Run(ctx, function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{headers}),
+	}, formatResp)
+	require.Nil(t, formatResp.Error)
+	assert.Equal(t, types.StringValue("Accept: application/json\nX-Api-Key: secret"), formatResp.Result.Value())
+
+	parseResp := &function.RunResponse{Result: function.NewResultData(types.MapUnknown(types.StringType))}
+	NewParseHeadersFunction().Run(ctx, function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{formatResp.Result.Value()}),
+	}, parseResp)
+	require.Nil(t, parseResp.Error)
+	assert.Equal(t, headers, parseResp.Result.Value())
+}
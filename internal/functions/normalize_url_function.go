@@ -0,0 +1,48 @@
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"terraform-provider-uptime/internal/resources"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &NormalizeURLFunction{}
+
+func NewNormalizeURLFunction() function.Function {
+	return &NormalizeURLFunction{}
+}
+
+// NormalizeURLFunction defines the function implementation.
+type NormalizeURLFunction struct{}
+
+func (f *NormalizeURLFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "normalize_url"
+}
+
+func (f *NormalizeURLFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Normalize a monitor URL",
+		MarkdownDescription: "Normalizes a URL the same way `uptime_monitor` does when storing it in state, e.g. by removing a trailing `/` path.",
+
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "url",
+				MarkdownDescription: "URL to normalize",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *NormalizeURLFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var url string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &url))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, resources.NormalizeURL(url)))
+}
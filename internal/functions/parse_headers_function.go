@@ -0,0 +1,51 @@
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-uptime/internal/resources"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &ParseHeadersFunction{}
+
+func NewParseHeadersFunction() function.Function {
+	return &ParseHeadersFunction{}
+}
+
+// ParseHeadersFunction defines the function implementation.
+type ParseHeadersFunction struct{}
+
+func (f *ParseHeadersFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_headers"
+}
+
+func (f *ParseHeadersFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Parse headers from the API wire format",
+		MarkdownDescription: "Parses a newline separated `Name: value` header string, as used by the API, into a map. Lines without a colon are ignored.",
+
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "headers",
+				MarkdownDescription: "Header string, e.g. `\"Accept: application/json\\nX-Api-Key: secret\"`",
+			},
+		},
+		Return: function.MapReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f *ParseHeadersFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var headers string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &headers))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, resources.ParseHeaders(headers)))
+}
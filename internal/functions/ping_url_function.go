@@ -0,0 +1,53 @@
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"terraform-provider-uptime/internal/resources"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &PingURLFunction{}
+
+func NewPingURLFunction() function.Function {
+	return &PingURLFunction{}
+}
+
+// PingURLFunction defines the function implementation.
+type PingURLFunction struct{}
+
+func (f *PingURLFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "ping_url"
+}
+
+func (f *PingURLFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Build a ping monitor URL",
+		MarkdownDescription: "Builds the URL sent to the API for a `ping` monitor, e.g. `ping://server.example.com`.",
+
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "host",
+				MarkdownDescription: "Hostname or IP address",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *PingURLFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var host string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &host))
+	if resp.Error != nil {
+		return
+	}
+
+	if host == "" {
+		resp.Error = function.NewArgumentFuncError(0, "host must not be empty")
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, resources.PingURL(host)))
+}
@@ -0,0 +1,64 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &TCPURLFunction{}
+
+func NewTCPURLFunction() function.Function {
+	return &TCPURLFunction{}
+}
+
+// TCPURLFunction defines the function implementation.
+type TCPURLFunction struct{}
+
+func (f *TCPURLFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "tcp_url"
+}
+
+func (f *TCPURLFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Build a TCP monitor URL",
+		MarkdownDescription: "Builds the `url` of a `tcp` monitor from a host and port, e.g. `tcp://db.example.com:5432`. IPv6 addresses are bracketed.",
+
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "host",
+				MarkdownDescription: "Hostname or IP address",
+			},
+			function.Int64Parameter{
+				Name:                "port",
+				MarkdownDescription: "TCP port (1-65535)",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *TCPURLFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var host string
+	var port int64
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &host, &port))
+	if resp.Error != nil {
+		return
+	}
+
+	if host == "" {
+		resp.Error = function.NewArgumentFuncError(0, "host must not be empty")
+		return
+	}
+	if port < 1 || port > 65535 {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("port must be between 1 and 65535, got %d", port))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, "tcp://"+net.JoinHostPort(host, strconv.FormatInt(port, 10))))
+}
@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"terraform-provider-uptime/internal/client"
+)
+
+// CredentialsErrorDiagnostic turns an error from the credentials check into
+// a diagnostic summary and detail that point at the likely misconfiguration
+func CredentialsErrorDiagnostic(err error, baseURL string) (string, string) {
+	var apiErr *client.APIError
+	var urlErr *url.Error
+
+	switch {
+	case errors.As(err, &apiErr) && (apiErr.StatusCode == http.StatusUnauthorized || apiErr.StatusCode == http.StatusForbidden):
+		return "Invalid API Key",
+			fmt.Sprintf("The Uptime Monitor API at %s rejected the API key (HTTP %d). "+
+				"Check that api_key or UPTIME_API_KEY holds a current, unrevoked key for this account.", baseURL, apiErr.StatusCode)
+	case errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound:
+		return "Invalid Base URL",
+			fmt.Sprintf("%s does not look like the Uptime Monitor API: the account endpoint returned HTTP 404. "+
+				"Check base_url or UPTIME_BASE_URL.", baseURL)
+	case errors.As(err, &apiErr):
+		return "Unable to Validate Credentials",
+			fmt.Sprintf("The Uptime Monitor API at %s returned an error while checking the credentials: %s", baseURL, err)
+	case errors.As(err, &urlErr):
+		return "Unable to Reach Uptime Monitor API",
+			fmt.Sprintf("Could not connect to %s: %s. "+
+				"Check base_url or UPTIME_BASE_URL, DNS, proxy settings (HTTPS_PROXY) and network access. "+
+				"Set skip_credentials_validation = true to configure the provider without contacting the API.", baseURL, urlErr.Err)
+	default:
+		return "Unexpected Response From Uptime Monitor API",
+			fmt.Sprintf("The response from %s could not be understood, which usually means base_url does not point at the Uptime Monitor API: %s", baseURL, err)
+	}
+}
@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"terraform-provider-uptime/internal/client"
+)
+
+func TestCredentialsErrorDiagnostic(t *testing.T) {
+	tests := []struct {
+		name        string
+		handler     http.HandlerFunc
+		closed      bool
+		wantSummary string
+	}{
+		{
+			name: "revoked key",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusUnauthorized)
+				_, _ = fmt.Fprint(w, `{"status":"error","error":"Invalid API key"}`)
+			},
+			wantSummary: "Invalid API Key",
+		},
+		{
+			name: "wrong base url",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusNotFound)
+			},
+			wantSummary: "Invalid Base URL",
+		},
+		{
+			name: "not the api",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				_, _ = fmt.Fprint(w, "<html>Welcome</html>")
+			},
+			wantSummary: "Unexpected Response From Uptime Monitor API",
+		},
+		{
+			name:        "unreachable host",
+			handler:     func(w http.ResponseWriter, r *http.Request) {},
+			closed:      true,
+			wantSummary: "Unable to Reach Uptime Monitor API",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(tt.handler)
+			if tt.closed {
+				server.Close()
+			} else {
+				defer server.Close()
+			}
+
+			_, err := client.NewClient(server.URL, "test-api-key").GetAccount()
+			require.Error(t, err)
+
+			summary, detail := CredentialsErrorDiagnostic(err, server.URL)
+			assert.Equal(t, tt.wantSummary, summary)
+			assert.Contains(t, detail, server.URL)
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// unknownConfigPaths returns the provider arguments whose values are not
+// known yet, e.g. because they refer to a resource that is still to be
+// created. It walks the configuration itself, so every argument and block
+// of the schema is covered.
+func unknownConfigPaths(config tftypes.Value) ([]path.Path, error) {
+	var unknown []path.Path
+
+	err := tftypes.Walk(config, func(attributePath *tftypes.AttributePath, value tftypes.Value) (bool, error) {
+		if value.IsKnown() {
+			return true, nil
+		}
+
+		p := path.Empty()
+		for _, step := range attributePath.Steps() {
+			switch step := step.(type) {
+			case tftypes.AttributeName:
+				p = p.AtName(string(step))
+			case tftypes.ElementKeyInt:
+				p = p.AtListIndex(int(step))
+			case tftypes.ElementKeyString:
+				p = p.AtMapKey(string(step))
+			}
+		}
+		unknown = append(unknown, p)
+
+		// Nothing below an unknown value is known either
+		return false, nil
+	})
+
+	return unknown, err
+}
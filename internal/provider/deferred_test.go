@@ -0,0 +1,122 @@
+package provider
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"terraform-provider-uptime/internal/client"
+)
+
+// configureRequest builds a ConfigureRequest whose api_key is unknown, with
+// the given values and every other argument null
+func configureRequest(t *testing.T, deferralAllowed bool, set map[string]tftypes.Value) provider.ConfigureRequest {
+	ctx := context.Background()
+
+	var schemaResp provider.SchemaResponse
+	New("test")().Schema(ctx, provider.SchemaRequest{}, &schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError())
+
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, typ := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(typ, nil)
+	}
+	values["api_key"] = tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+	for name, value := range set {
+		values[name] = value
+	}
+
+	return provider.ConfigureRequest{
+		Config: tfsdk.Config{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(objectType, values),
+		},
+		ClientCapabilities: provider.ConfigureProviderClientCapabilities{
+			DeferralAllowed: deferralAllowed,
+		},
+	}
+}
+
+// skipValidation skips checking the credentials against the API
+var skipValidation = map[string]tftypes.Value{
+	"skip_credentials_validation": tftypes.NewValue(tftypes.Bool, true),
+}
+
+func TestConfigure_UnknownConfig(t *testing.T) {
+	t.Setenv("UPTIME_CONFIG_FILE", filepath.Join(t.TempDir(), "credentials"))
+	t.Setenv("UPTIME_PROFILE", "")
+
+	t.Run("deferral allowed", func(t *testing.T) {
+		t.Setenv("UPTIME_API_KEY", "env-key")
+
+		var resp provider.ConfigureResponse
+		New("test")().Configure(context.Background(), configureRequest(t, true, skipValidation), &resp)
+
+		assert.False(t, resp.Diagnostics.HasError())
+		require.NotNil(t, resp.Deferred)
+		assert.Equal(t, provider.DeferredReasonProviderConfigUnknown, resp.Deferred.Reason)
+		assert.Nil(t, resp.ResourceData)
+	})
+
+	t.Run("deferral not allowed, key from environment", func(t *testing.T) {
+		t.Setenv("UPTIME_API_KEY", "env-key")
+
+		var resp provider.ConfigureResponse
+		New("test")().Configure(context.Background(), configureRequest(t, false, skipValidation), &resp)
+
+		assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+		assert.Nil(t, resp.Deferred)
+		assert.NotNil(t, resp.ResourceData)
+	})
+
+	t.Run("deferral not allowed, no key", func(t *testing.T) {
+		t.Setenv("UPTIME_API_KEY", "")
+
+		var resp provider.ConfigureResponse
+		New("test")().Configure(context.Background(), configureRequest(t, false, skipValidation), &resp)
+
+		assert.Nil(t, resp.Deferred)
+		require.True(t, resp.Diagnostics.HasError())
+		assert.Equal(t, "Unknown Provider Configuration", resp.Diagnostics.Errors()[0].Summary())
+	})
+}
+
+func TestConfigure_UnknownMonitorDefaults(t *testing.T) {
+	t.Setenv("UPTIME_CONFIG_FILE", filepath.Join(t.TempDir(), "credentials"))
+	t.Setenv("UPTIME_API_KEY", "env-key")
+
+	req := configureRequest(t, false, skipValidation)
+	defaultsType := req.Config.Raw.Type().(tftypes.Object).AttributeTypes["monitor_defaults"].(tftypes.Object)
+	defaults := make(map[string]tftypes.Value, len(defaultsType.AttributeTypes))
+	for name, typ := range defaultsType.AttributeTypes {
+		defaults[name] = tftypes.NewValue(typ, nil)
+	}
+	defaults["check_interval"] = tftypes.NewValue(tftypes.Number, tftypes.UnknownValue)
+	req = configureRequest(t, false, map[string]tftypes.Value{
+		"skip_credentials_validation": tftypes.NewValue(tftypes.Bool, true),
+		"monitor_defaults":            tftypes.NewValue(defaultsType, defaults),
+	})
+
+	var resp provider.ConfigureResponse
+	New("test")().Configure(context.Background(), req, &resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	c, ok := resp.ResourceData.(*client.Client)
+	require.True(t, ok)
+	assert.True(t, c.MonitorDefaults.Unknown)
+}
+
+func TestUnknownConfigPaths(t *testing.T) {
+	req := configureRequest(t, false, nil)
+
+	unknown, err := unknownConfigPaths(req.Config.Raw)
+	require.NoError(t, err)
+	assert.Equal(t, []path.Path{path.Root("api_key")}, unknown)
+}
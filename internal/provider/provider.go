@@ -2,22 +2,30 @@ package provider
 
 import (
 	"context"
-	"os"
+	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"terraform-provider-uptime/internal/client"
+	"terraform-provider-uptime/internal/config"
 	"terraform-provider-uptime/internal/datasources"
+	"terraform-provider-uptime/internal/functions"
 	"terraform-provider-uptime/internal/resources"
 )
 
 // Ensure UptimeProvider satisfies various provider interfaces.
 var _ provider.Provider = &UptimeProvider{}
 var _ provider.ProviderWithFunctions = &UptimeProvider{}
+var _ provider.ProviderWithListResources = &UptimeProvider{}
 
 // UptimeProvider defines the provider implementation.
 type UptimeProvider struct {
@@ -29,8 +37,28 @@ type UptimeProvider struct {
 
 // UptimeProviderModel describes the provider data model.
 type UptimeProviderModel struct {
-	ApiKey  types.String `tfsdk:"api_key"`
-	BaseUrl types.String `tfsdk:"base_url"`
+	ApiKey        types.String `tfsdk:"api_key"`
+	ApiKeyCommand types.String `tfsdk:"api_key_command"`
+	BaseUrl       types.String `tfsdk:"base_url"`
+	Profile       types.String `tfsdk:"profile"`
+
+	EnforceQuota types.Bool   `tfsdk:"enforce_quota"`
+	NamePrefix   types.String `tfsdk:"name_prefix"`
+	NameSuffix   types.String `tfsdk:"name_suffix"`
+	ReadOnly     types.Bool   `tfsdk:"read_only"`
+
+	SkipCredentialsValidation types.Bool `tfsdk:"skip_credentials_validation"`
+
+	MonitorDefaults *MonitorDefaultsModel `tfsdk:"monitor_defaults"`
+}
+
+// MonitorDefaultsModel describes the monitor_defaults block.
+type MonitorDefaultsModel struct {
+	Regions       types.List  `tfsdk:"regions"`
+	Contacts      types.List  `tfsdk:"contacts"`
+	CheckInterval types.Int64 `tfsdk:"check_interval"`
+	Timeout       types.Int64 `tfsdk:"timeout"`
+	FailThreshold types.Int64 `tfsdk:"fail_threshold"`
 }
 
 func (p *UptimeProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -43,14 +71,85 @@ func (p *UptimeProvider) Schema(ctx context.Context, req provider.SchemaRequest,
 		MarkdownDescription: "The Uptime Monitor provider allows you to manage monitors, contacts, and status pages for the Uptime Monitor service.",
 		Attributes: map[string]schema.Attribute{
 			"api_key": schema.StringAttribute{
-				MarkdownDescription: "API key for authenticating with the Uptime Monitor service. Can also be set via the UPTIME_API_KEY environment variable.",
+				MarkdownDescription: "API key for authenticating with the Uptime Monitor service. Can also be set via the UPTIME_API_KEY environment variable or a credentials file profile.",
 				Optional:            true,
 				Sensitive:           true,
 			},
+			"api_key_command": schema.StringAttribute{
+				MarkdownDescription: "Command that prints the API key, for keys kept in a secrets manager. It is run through `sh -c` (`cmd /C` on Windows) and must print a JSON object such as `{\"api_key\": \"...\", \"expires_at\": \"2025-01-01T00:00:00Z\"}`, where `expires_at` is optional. " +
+					"The command is run again when the key expires or the API rejects it.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("api_key")),
+				},
+			},
 			"base_url": schema.StringAttribute{
-				MarkdownDescription: "Base URL for the Uptime Monitor API. Defaults to production API. Can also be set via the UPTIME_BASE_URL environment variable.",
+				MarkdownDescription: "Base URL for the Uptime Monitor API. Defaults to production API. Can also be set via the UPTIME_BASE_URL environment variable or a credentials file profile.",
+				Optional:            true,
+			},
+			"profile": schema.StringAttribute{
+				MarkdownDescription: "Profile to read from the credentials file (`~/.config/uptime/credentials`, or the path in UPTIME_CONFIG_FILE). Can also be set via the UPTIME_PROFILE environment variable. Defaults to `default`. " +
+					"Settings in the provider configuration take precedence over the profile, which takes precedence over the UPTIME_API_KEY and UPTIME_BASE_URL environment variables when selected here or via UPTIME_PROFILE.",
+				Optional: true,
+			},
+			"enforce_quota": schema.BoolAttribute{
+				MarkdownDescription: "Fail the plan instead of warning when the monitors it creates would exceed the account's monitor limit. Defaults to `false`.",
+				Optional:            true,
+			},
+			"name_prefix": schema.StringAttribute{
+				MarkdownDescription: "Prefix added to the names of monitors, contacts and status pages in the account, e.g. `[staging] `. It is removed again when reading, so configuration and state stay identical across environments. Lookups by name, imports by name and list resources only see objects carrying the prefix.",
 				Optional:            true,
 			},
+			"name_suffix": schema.StringAttribute{
+				MarkdownDescription: "Suffix added to the names of monitors, contacts and status pages in the account, e.g. ` (staging)`. It is removed again when reading, and lookups only see objects carrying the suffix.",
+				Optional:            true,
+			},
+			"read_only": schema.BoolAttribute{
+				MarkdownDescription: "Refuse to change anything in the account. Plans that would create, update or delete a monitor, contact or status page fail, and the client rejects any request other than a read. Useful for audit and drift-detection runs with production credentials. Defaults to `false`.",
+				Optional:            true,
+			},
+			"skip_credentials_validation": schema.BoolAttribute{
+				MarkdownDescription: "Skip checking the API key and base URL against the API when the provider is configured, e.g. for offline `terraform validate`. Defaults to `false`.",
+				Optional:            true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"monitor_defaults": schema.SingleNestedBlock{
+				MarkdownDescription: "Default settings for every `uptime_monitor` that leaves them unset. Values set on a monitor always take precedence, and the effective values are shown in the plan.",
+				Attributes: map[string]schema.Attribute{
+					"regions": schema.ListAttribute{
+						MarkdownDescription: "Default regions to perform checks from",
+						ElementType:         types.StringType,
+						Optional:            true,
+					},
+					"contacts": schema.ListAttribute{
+						MarkdownDescription: "Default contact IDs to notify when monitor status changes",
+						ElementType:         types.StringType,
+						Optional:            true,
+					},
+					"check_interval": schema.Int64Attribute{
+						MarkdownDescription: "Default check interval in seconds",
+						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+					"timeout": schema.Int64Attribute{
+						MarkdownDescription: "Default request timeout in seconds",
+						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+					"fail_threshold": schema.Int64Attribute{
+						MarkdownDescription: "Default number of consecutive failed checks before marking a monitor as down",
+						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -64,25 +163,59 @@ func (p *UptimeProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		return
 	}
 
-	// Default values to environment variables, but let explicit configuration override
-	apiKey := data.ApiKey.ValueString()
-	if apiKey == "" {
-		apiKey = os.Getenv("UPTIME_API_KEY")
+	// Arguments that refer to resources created in the same run are unknown
+	// until apply. Defer planning the provider's resources and data sources
+	// when Terraform supports it. Otherwise the unknown arguments are treated
+	// as unset, so the environment and the credentials file can still supply
+	// the credentials.
+	unknown, err := unknownConfigPaths(req.Config.Raw)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Read Provider Configuration", err.Error())
+		return
 	}
-
-	baseUrl := data.BaseUrl.ValueString()
-	if baseUrl == "" {
-		baseUrl = os.Getenv("UPTIME_BASE_URL")
-		if baseUrl == "" {
-			baseUrl = "https://api.uptime-monitor.io" // Default to production API
+	if len(unknown) > 0 && req.ClientCapabilities.DeferralAllowed {
+		resp.Deferred = &provider.Deferred{
+			Reason: provider.DeferredReasonProviderConfigUnknown,
 		}
+		return
+	}
+
+	// Resolve credentials from configuration, environment variables and the
+	// credentials file, in that order
+	resolved, err := config.Resolve(config.Options{
+		APIKey:        data.ApiKey.ValueString(),
+		APIKeyCommand: data.ApiKeyCommand.ValueString(),
+		BaseURL:       data.BaseUrl.ValueString(),
+		Profile:       data.Profile.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Resolve Provider Credentials",
+			fmt.Sprintf("Unable to read provider credentials: %s", err),
+		)
+		return
 	}
 
-	if apiKey == "" {
+	apiKey := resolved.APIKey
+	baseUrl := resolved.BaseURL
+
+	if apiKey == "" && len(unknown) > 0 {
+		for _, p := range unknown {
+			resp.Diagnostics.AddAttributeError(
+				p,
+				"Unknown Provider Configuration",
+				"The provider cannot create the Uptime Monitor API client as this value is not known yet "+
+					"and no API key was found in the UPTIME_API_KEY environment variable or the credentials file. "+
+					"Either set it to a value known at plan time, apply the resources it depends on first "+
+					"(e.g. with -target), or use a Terraform version that supports deferred actions.",
+			)
+		}
+	} else if apiKey == "" {
 		resp.Diagnostics.AddError(
 			"Missing API Key Configuration",
 			"While configuring the provider, the API key was not found in "+
-				"the configuration or UPTIME_API_KEY environment variable. "+
+				"the configuration, the UPTIME_API_KEY environment variable or "+
+				fmt.Sprintf("profile %q of the credentials file %s. ", resolved.Profile, resolved.ProfilePath)+
 				"This is required for the provider to authenticate with the Uptime Monitor API.",
 		)
 	}
@@ -94,9 +227,45 @@ func (p *UptimeProvider) Configure(ctx context.Context, req provider.ConfigureRe
 	// Create API client and make it available during DataSource and Resource
 	// type Configure methods.
 	client := client.NewClient(baseUrl, apiKey)
+	if resolved.RefreshAPIKey != nil {
+		client.SetAPIKeyFunc(resolved.RefreshAPIKey, resolved.APIKeyExpiresAt)
+	}
+	client.EnforceQuota = data.EnforceQuota.ValueBool()
+	client.NamePrefix = data.NamePrefix.ValueString()
+	client.NameSuffix = data.NameSuffix.ValueString()
+	client.ReadOnly = data.ReadOnly.ValueBool()
+
+	for _, p := range unknown {
+		if first, _ := p.Steps().NextStep(); first.Equal(path.PathStepAttributeName("monitor_defaults")) {
+			client.MonitorDefaults.Unknown = true
+		}
+	}
+	if data.MonitorDefaults != nil && !client.MonitorDefaults.Unknown {
+		defaults := data.MonitorDefaults
+		client.MonitorDefaults.CheckInterval = int(defaults.CheckInterval.ValueInt64())
+		client.MonitorDefaults.Timeout = int(defaults.Timeout.ValueInt64())
+		client.MonitorDefaults.FailThreshold = int(defaults.FailThreshold.ValueInt64())
+		resp.Diagnostics.Append(defaults.Regions.ElementsAs(ctx, &client.MonitorDefaults.Regions, false)...)
+		resp.Diagnostics.Append(defaults.Contacts.ElementsAs(ctx, &client.MonitorDefaults.Contacts, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Check the credentials once up front, so an invalid key or base URL is
+	// reported clearly instead of failing on the first resource. The fetched
+	// account is cached on the client for the rest of the run.
+	if !data.SkipCredentialsValidation.ValueBool() {
+		if _, err := client.GetAccountCached(); err != nil {
+			summary, detail := CredentialsErrorDiagnostic(err, baseUrl)
+			resp.Diagnostics.AddError(summary, detail)
+			return
+		}
+	}
 
 	resp.DataSourceData = client
 	resp.ResourceData = client
+	resp.ListResourceData = client
 }
 
 func (p *UptimeProvider) Resources(ctx context.Context) []func() resource.Resource {
@@ -107,17 +276,35 @@ func (p *UptimeProvider) Resources(ctx context.Context) []func() resource.Resour
 	}
 }
 
+func (p *UptimeProvider) ListResources(ctx context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		resources.NewMonitorListResource,
+		resources.NewContactListResource,
+		resources.NewStatusPageListResource,
+	}
+}
+
 func (p *UptimeProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		datasources.NewMonitorDataSource,
 		datasources.NewAccountDataSource,
 		datasources.NewStatusPageDataSource,
+		datasources.NewStatusPagesDataSource,
+		datasources.NewRegionsDataSource,
+		datasources.NewIPRangesDataSource,
+		datasources.NewMonitorStatsDataSource,
+		datasources.NewIncidentsDataSource,
 	}
 }
 
 func (p *UptimeProvider) Functions(ctx context.Context) []func() function.Function {
 	return []func() function.Function{
-		// No custom functions for now
+		functions.NewNormalizeURLFunction,
+		functions.NewExpandStatusCodesFunction,
+		functions.NewTCPURLFunction,
+		functions.NewPingURLFunction,
+		functions.NewFormatHeadersFunction,
+		functions.NewParseHeadersFunction,
 	}
 }
 
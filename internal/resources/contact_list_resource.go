@@ -0,0 +1,119 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-uptime/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ list.ListResource = &ContactListResource{}
+var _ list.ListResourceWithConfigure = &ContactListResource{}
+
+func NewContactListResource() list.ListResource {
+	return &ContactListResource{}
+}
+
+// ContactListResource lists existing contacts for `terraform query`.
+type ContactListResource struct {
+	client *client.Client
+}
+
+// ContactListResourceModel describes the list block configuration.
+type ContactListResourceModel struct {
+	Name    types.String `tfsdk:"name"`
+	Channel types.String `tfsdk:"channel"`
+}
+
+func (l *ContactListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_contact"
+}
+
+func (l *ContactListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		MarkdownDescription: "Lists the contacts in the account, optionally filtered by name and channel",
+
+		Attributes: map[string]listschema.Attribute{
+			"name": listschema.StringAttribute{
+				MarkdownDescription: "Only list contacts whose name contains this text, ignoring case",
+				Optional:            true,
+			},
+			"channel": listschema.StringAttribute{
+				MarkdownDescription: "Only list contacts of this channel type (email, sms, webhook, slack, discord, pagerduty, incidentio, opsgenie, zendesk)",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("email", "sms", "webhook", "slack", "discord", "pagerduty", "incidentio", "opsgenie", "zendesk"),
+				},
+			},
+		},
+	}
+}
+
+func (l *ContactListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	l.client = client
+}
+
+func (l *ContactListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data ContactListResourceModel
+
+	diags := req.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	contacts, err := l.client.ListContacts()
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list contacts, got error: %s", err))
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	var matches []client.Contact
+	for _, contact := range contacts {
+		if !nameMatches(data.Name, contact.Name) || (!data.Channel.IsNull() && contact.Channel != data.Channel.ValueString()) {
+			continue
+		}
+		matches = append(matches, contact)
+	}
+
+	stream.Results = listResults(ctx, req, matches, func(contact client.Contact, result *list.ListResult) {
+		result.DisplayName = contact.Name
+		result.Diagnostics.Append(setIdentity(ctx, result.Identity, types.StringValue(contact.ID))...)
+
+		if !req.IncludeResource {
+			return
+		}
+
+		model, diags := contactModel(ctx, &contact)
+		result.Diagnostics.Append(diags...)
+		if result.Diagnostics.HasError() {
+			return
+		}
+
+		result.Diagnostics.Append(result.Resource.Set(ctx, &model)...)
+	})
+}
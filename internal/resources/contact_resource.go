@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -22,6 +23,8 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &ContactResource{}
 var _ resource.ResourceWithImportState = &ContactResource{}
+var _ resource.ResourceWithIdentity = &ContactResource{}
+var _ resource.ResourceWithModifyPlan = &ContactResource{}
 
 func NewContactResource() resource.Resource {
 	return &ContactResource{}
@@ -49,6 +52,8 @@ type ContactResourceModel struct {
 	IncidentioSettings types.Object `tfsdk:"incidentio_settings"`
 	OpsgenieSettings   types.Object `tfsdk:"opsgenie_settings"`
 	ZendeskSettings    types.Object `tfsdk:"zendesk_settings"`
+	DeletionProtection types.Bool   `tfsdk:"deletion_protection"`
+	ForceDetach        types.Bool   `tfsdk:"force_detach"`
 }
 
 // Settings models for each channel type
@@ -159,6 +164,8 @@ func (r *ContactResource) Schema(ctx context.Context, req resource.SchemaRequest
 				Computed:            true,
 				Default:             booldefault.StaticBool(false),
 			},
+			"deletion_protection": deletionProtectionAttribute("contact"),
+			"force_detach":        forceDetachAttribute("contact", "monitors"),
 			"error": schema.StringAttribute{
 				MarkdownDescription: "Error message if contact failed (e.g., email bounce)",
 				Computed:            true,
@@ -413,6 +420,10 @@ func (r *ContactResource) Schema(ctx context.Context, req resource.SchemaRequest
 	}
 }
 
+func (r *ContactResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("contact")
+}
+
 func (r *ContactResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
@@ -434,6 +445,11 @@ func (r *ContactResource) Configure(ctx context.Context, req resource.ConfigureR
 }
 
 func (r *ContactResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	checkReadOnly(r.client, "contact", req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Skip validation during resource destruction
 	if req.Plan.Raw.IsNull() {
 		return
@@ -490,6 +506,7 @@ func (r *ContactResource) Create(ctx context.Context, req resource.CreateRequest
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, data.ID)...)
 }
 
 func (r *ContactResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -516,26 +533,17 @@ func (r *ContactResource) Read(ctx context.Context, req resource.ReadRequest, re
 	}
 
 	// Update the model with API data
-	data.Name = types.StringValue(contact.Name)
-	data.Channel = types.StringValue(contact.Channel)
-	data.Active = types.BoolValue(contact.Active)
-	data.DownAlertsOnly = types.BoolValue(contact.DownAlertsOnly)
-
-	if contact.Error != nil {
-		data.Error = types.StringValue(*contact.Error)
-	} else {
-		data.Error = types.StringNull()
-	}
-
-	// Parse details JSON back into appropriate settings
-	err = r.parseDetailsJSON(ctx, contact.Channel, contact.Details, &data)
+	err = r.contactToModel(ctx, contact, &data)
 	if err != nil {
 		resp.Diagnostics.AddError("Data Conversion Error", fmt.Sprintf("Unable to parse contact details: %s", err))
 		return
 	}
+	data.DeletionProtection = stateFlagValue(data.DeletionProtection)
+	data.ForceDetach = stateFlagValue(data.ForceDetach)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, data.ID)...)
 }
 
 func (r *ContactResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -603,6 +611,7 @@ func (r *ContactResource) Update(ctx context.Context, req resource.UpdateRequest
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, data.ID)...)
 }
 
 func (r *ContactResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -615,6 +624,16 @@ func (r *ContactResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
+	checkDeletionProtection(data.DeletionProtection, "contact", data.ID.ValueString(), resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.detachFromMonitors(&data, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Delete contact via API
 	err := r.client.DeleteContact(data.ID.ValueString())
 	if err != nil {
@@ -623,8 +642,79 @@ func (r *ContactResource) Delete(ctx context.Context, req resource.DeleteRequest
 	}
 }
 
+// detachFromMonitors removes the contact from the monitors that still notify
+// it when force_detach is set, and fails the deletion otherwise
+func (r *ContactResource) detachFromMonitors(data *ContactResourceModel, resp *resource.DeleteResponse) {
+	id := data.ID.ValueString()
+
+	monitors, err := r.client.MonitorsUsingContact(id)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find monitors using contact: %s", err))
+		return
+	}
+	if len(monitors) == 0 {
+		return
+	}
+
+	if !data.ForceDetach.ValueBool() {
+		names := make([]string, 0, len(monitors))
+		for _, monitor := range monitors {
+			names = append(names, describeObject(monitor.Name, monitor.ID))
+		}
+		resp.Diagnostics.AddError(
+			"Contact In Use",
+			fmt.Sprintf("Contact %s is still used by these monitors: %s. "+
+				"Remove it from them first, or set force_detach = true and apply before deleting the contact.", id, strings.Join(names, ", ")),
+		)
+		return
+	}
+
+	for _, monitor := range monitors {
+		if err := r.client.SetMonitorContacts(monitor.ID, withoutID(monitor.Contacts, id)); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove contact from monitor %s: %s", monitor.ID, err))
+			return
+		}
+	}
+}
+
+// ImportState accepts a contact ID, an identity or a natural key made of
+// name and channel, e.g. `channel=slack,name=ops-alerts`
 func (r *ContactResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	key, err := parseImportID(req.ID, "name", "channel")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Unable to parse import ID %q: %s", req.ID, err))
+		return
+	}
+	if key == nil {
+		resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+		return
+	}
+
+	contacts, err := r.client.ListContacts()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list contacts, got error: %s", err))
+		return
+	}
+
+	var matches []importMatch
+	for _, contact := range contacts {
+		if name, ok := key["name"]; ok && contact.Name != name {
+			continue
+		}
+		if channel, ok := key["channel"]; ok && contact.Channel != channel {
+			continue
+		}
+		matches = append(matches, importMatch{ID: contact.ID, Name: contact.Name})
+	}
+
+	id, err := resolveImportMatch("contact", key, matches)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Import Contact", fmt.Sprintf("Cannot resolve import ID %q: %s", req.ID, err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, types.StringValue(id))...)
 }
 
 // Helper functions
@@ -824,6 +914,25 @@ func (r *ContactResource) buildDetailsJSON(ctx context.Context, data *ContactRes
 	return json.Marshal(details)
 }
 
+// contactToModel copies a contact returned by the API into the Terraform
+// model
+func (r *ContactResource) contactToModel(ctx context.Context, contact *client.Contact, data *ContactResourceModel) error {
+	data.ID = types.StringValue(contact.ID)
+	data.Name = types.StringValue(contact.Name)
+	data.Channel = types.StringValue(contact.Channel)
+	data.Active = types.BoolValue(contact.Active)
+	data.DownAlertsOnly = types.BoolValue(contact.DownAlertsOnly)
+
+	if contact.Error != nil {
+		data.Error = types.StringValue(*contact.Error)
+	} else {
+		data.Error = types.StringNull()
+	}
+
+	// Parse details JSON back into appropriate settings
+	return r.parseDetailsJSON(ctx, contact.Channel, contact.Details, data)
+}
+
 func (r *ContactResource) parseDetailsJSON(ctx context.Context, channel string, details json.RawMessage, data *ContactResourceModel) error {
 	// Clear all settings first
 	data.EmailSettings = types.ObjectNull(r.getEmailSettingsAttrs())
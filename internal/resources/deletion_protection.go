@@ -0,0 +1,45 @@
+package resources
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// deletionProtectionAttribute returns the deletion_protection attribute of
+// a resource. kind names the resource in its description, e.g. "monitor".
+func deletionProtectionAttribute(kind string) schema.BoolAttribute {
+	return schema.BoolAttribute{
+		MarkdownDescription: fmt.Sprintf("Refuse to delete the %s while set. To delete a protected %s, set this to `false` and apply before destroying or replacing it. Only stored in Terraform state. Defaults to `false`.", kind, kind),
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+	}
+}
+
+// stateFlagValue returns the state value of a flag that is only stored in
+// Terraform state, such as deletion_protection or force_detach, treating
+// null (after an import or a provider upgrade) as false
+func stateFlagValue(v types.Bool) types.Bool {
+	if v.IsNull() || v.IsUnknown() {
+		return types.BoolValue(false)
+	}
+	return v
+}
+
+// checkDeletionProtection fails the deletion of a resource whose state has
+// deletion_protection enabled
+func checkDeletionProtection(protected types.Bool, kind, id string, resp *resource.DeleteResponse) {
+	if !protected.ValueBool() {
+		return
+	}
+
+	resp.Diagnostics.AddError(
+		"Deletion Protection Enabled",
+		fmt.Sprintf("Cannot delete %s %s while deletion_protection is true. "+
+			"Set deletion_protection = false and apply that change before destroying or replacing it.", kind, id),
+	)
+}
@@ -0,0 +1,29 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckDeletionProtection(t *testing.T) {
+	resp := &resource.DeleteResponse{}
+	checkDeletionProtection(types.BoolValue(true), "status page", "page123", resp)
+	assert.True(t, resp.Diagnostics.HasError())
+	assert.Equal(t, "Deletion Protection Enabled", resp.Diagnostics.Errors()[0].Summary())
+	assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), "status page page123")
+
+	for _, v := range []types.Bool{types.BoolValue(false), types.BoolNull()} {
+		resp := &resource.DeleteResponse{}
+		checkDeletionProtection(v, "status page", "page123", resp)
+		assert.False(t, resp.Diagnostics.HasError())
+	}
+}
+
+func TestStateFlagValue(t *testing.T) {
+	assert.Equal(t, types.BoolValue(false), stateFlagValue(types.BoolNull()))
+	assert.Equal(t, types.BoolValue(false), stateFlagValue(types.BoolValue(false)))
+	assert.Equal(t, types.BoolValue(true), stateFlagValue(types.BoolValue(true)))
+}
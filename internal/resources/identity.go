@@ -0,0 +1,39 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// IDIdentityModel describes the resource identity shared by all resources,
+// which is the object's ID.
+type IDIdentityModel struct {
+	ID types.String `tfsdk:"id"`
+}
+
+// idIdentitySchema returns the identity schema of a resource identified by
+// its ID. kind names the resource in the description, e.g. "monitor".
+func idIdentitySchema(kind string) identityschema.Schema {
+	return identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       fmt.Sprintf("The %s identifier", kind),
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+// setIdentity stores id as the resource identity. identity is nil when
+// Terraform does not support resource identity.
+func setIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, id types.String) diag.Diagnostics {
+	if identity == nil {
+		return nil
+	}
+	return identity.Set(ctx, IDIdentityModel{ID: id})
+}
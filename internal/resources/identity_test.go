@@ -0,0 +1,36 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceIdentity(t *testing.T) {
+	ctx := context.Background()
+
+	for _, r := range []resource.ResourceWithIdentity{&MonitorResource{}, &ContactResource{}, &StatusPageResource{}} {
+		resp := &resource.IdentitySchemaResponse{}
+		r.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, resp)
+		require.False(t, resp.Diagnostics.HasError())
+
+		identity := &tfsdk.ResourceIdentity{
+			Schema: resp.IdentitySchema,
+			Raw:    tftypes.NewValue(resp.IdentitySchema.Type().TerraformType(ctx), nil),
+		}
+		require.False(t, setIdentity(ctx, identity, types.StringValue("abc123")).HasError())
+
+		var got IDIdentityModel
+		require.False(t, identity.Get(ctx, &got).HasError())
+		assert.Equal(t, "abc123", got.ID.ValueString())
+	}
+
+	// Terraform versions without identity support pass no identity
+	assert.False(t, setIdentity(ctx, nil, types.StringValue("abc123")).HasError())
+}
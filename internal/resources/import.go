@@ -0,0 +1,101 @@
+package resources
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// importKeyPattern matches what looks like a key in a natural-key import ID
+var importKeyPattern = regexp.MustCompile(`^[a-z_]+$`)
+
+// importKey is a natural-key import ID such as `channel=slack,name=ops-alerts`,
+// mapping each key to the value it must match
+type importKey map[string]string
+
+// parseImportID parses a natural-key import ID made of comma-separated
+// key=value pairs, accepting only the given keys. Plain IDs, which contain
+// no key=value pair, return a nil importKey. A comma followed by anything
+// other than a known key is part of the previous value, so values such as
+// URLs may contain commas.
+func parseImportID(id string, keys ...string) (importKey, error) {
+	known := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		known[key] = true
+	}
+
+	first, _, ok := strings.Cut(id, "=")
+	if !ok {
+		return nil, nil
+	}
+	if first = strings.TrimSpace(first); !known[first] {
+		if importKeyPattern.MatchString(first) {
+			return nil, fmt.Errorf("unknown import key %q, expected one of: %s", first, strings.Join(keys, ", "))
+		}
+		return nil, nil
+	}
+
+	result := make(importKey)
+	var last string
+	for _, part := range strings.Split(id, ",") {
+		key, value, ok := strings.Cut(part, "=")
+		key = strings.TrimSpace(key)
+		if !ok || !known[key] {
+			result[last] += "," + part
+			continue
+		}
+
+		if _, duplicate := result[key]; duplicate {
+			return nil, fmt.Errorf("import key %q is given more than once", key)
+		}
+		result[key] = value
+		last = key
+	}
+
+	// Spaces around values, e.g. `name = Checkout API` or a trailing newline
+	// from a generated ID, are not part of them
+	for key, value := range result {
+		value = strings.TrimSpace(value)
+		result[key] = value
+		if value == "" {
+			return nil, fmt.Errorf("import key %q has an empty value", key)
+		}
+	}
+
+	return result, nil
+}
+
+// String formats the key as sorted key=value pairs
+func (k importKey) String() string {
+	pairs := make([]string, 0, len(k))
+	for key, value := range k {
+		pairs = append(pairs, key+"="+value)
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
+
+// importMatch is an object that matches a natural-key import ID
+type importMatch struct {
+	ID   string
+	Name string
+}
+
+// resolveImportMatch returns the ID of the single object matching key.
+// kind names the objects in errors, e.g. "monitor".
+func resolveImportMatch(kind string, key importKey, matches []importMatch) (string, error) {
+	switch len(matches) {
+	case 0:
+		return "", fmt.Errorf("no %s matches %s", kind, key)
+	case 1:
+		return matches[0].ID, nil
+	}
+
+	described := make([]string, 0, len(matches))
+	for _, match := range matches {
+		described = append(described, describeObject(match.Name, match.ID))
+	}
+	return "", fmt.Errorf("%d %ss match %s: %s. Add more keys to narrow the match, or import by ID",
+		len(matches), kind, key, strings.Join(described, ", "))
+}
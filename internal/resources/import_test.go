@@ -0,0 +1,71 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"terraform-provider-uptime/internal/client"
+)
+
+func TestParseImportID(t *testing.T) {
+	tests := []struct {
+		name    string
+		id      string
+		want    importKey
+		wantErr bool
+	}{
+		{name: "plain id", id: "abc123", want: nil},
+		{name: "name", id: "name=Checkout API", want: importKey{"name": "Checkout API"}},
+		{name: "two keys", id: "channel=slack,name=ops-alerts", want: importKey{"channel": "slack", "name": "ops-alerts"}},
+		{name: "comma in value", id: "url=https://example.com/a,b,name=API", want: importKey{"url": "https://example.com/a,b", "name": "API"}},
+		{name: "equals in value", id: "url=https://example.com/?a=b", want: importKey{"url": "https://example.com/?a=b"}},
+		{name: "unknown key", id: "domain=status.example.com", wantErr: true},
+		{name: "duplicate key", id: "name=a,name=b", wantErr: true},
+		{name: "spaces around keys and values", id: "name = Checkout API , channel= slack\n", want: importKey{"name": "Checkout API", "channel": "slack"}},
+		{name: "empty value", id: "name=", wantErr: true},
+		{name: "blank value", id: "name= ", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseImportID(tt.id, "name", "url", "channel")
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestResolveImportMatch(t *testing.T) {
+	key := importKey{"name": "API"}
+
+	_, err := resolveImportMatch("monitor", key, nil)
+	assert.ErrorContains(t, err, "no monitor matches name=API")
+
+	id, err := resolveImportMatch("monitor", key, []importMatch{{ID: "m1", Name: "API"}})
+	require.NoError(t, err)
+	assert.Equal(t, "m1", id)
+
+	_, err = resolveImportMatch("monitor", key, []importMatch{{ID: "m1", Name: "API"}, {ID: "m2", Name: "API"}})
+	assert.ErrorContains(t, err, "2 monitors match name=API")
+	assert.ErrorContains(t, err, "m2")
+}
+
+func TestMonitorMatchesImportKey(t *testing.T) {
+	monitor := &client.Monitor{
+		ID:   "m1",
+		Name: "Checkout API",
+		Settings: client.MonitorSettings{
+			HTTPS: &client.HTTPSSettings{URL: "https://shop.example.com/"},
+		},
+	}
+
+	assert.True(t, monitorMatchesImportKey(monitor, importKey{"name": "Checkout API"}))
+	assert.True(t, monitorMatchesImportKey(monitor, importKey{"url": "https://shop.example.com", "type": "https"}))
+	assert.False(t, monitorMatchesImportKey(monitor, importKey{"url": "https://shop.example.com", "type": "tcp"}))
+	assert.False(t, monitorMatchesImportKey(monitor, importKey{"name": "Checkout"}))
+}
@@ -0,0 +1,38 @@
+package resources
+
+import (
+	"context"
+	"iter"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// nameMatches reports whether name contains the name filter of a list
+// block, ignoring case. A null filter matches every name.
+func nameMatches(filter types.String, name string) bool {
+	if filter.IsNull() {
+		return true
+	}
+	return strings.Contains(strings.ToLower(name), strings.ToLower(filter.ValueString()))
+}
+
+// listResults returns an iterator that pushes one result per object, up to
+// the request's limit. fill sets the result's display name, identity and,
+// when requested, resource data.
+func listResults[T any](ctx context.Context, req list.ListRequest, objects []T, fill func(T, *list.ListResult)) iter.Seq[list.ListResult] {
+	return func(push func(list.ListResult) bool) {
+		for i, object := range objects {
+			if req.Limit > 0 && int64(i) >= req.Limit {
+				return
+			}
+
+			result := req.NewListResult(ctx)
+			fill(object, &result)
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
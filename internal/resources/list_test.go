@@ -0,0 +1,141 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"terraform-provider-uptime/internal/client"
+)
+
+// listRequest builds a ListRequest for a list resource and its managed
+// resource, with the given list block configuration
+func listRequest(t *testing.T, l list.ListResource, r resource.ResourceWithIdentity, config map[string]string, includeResource bool) list.ListRequest {
+	ctx := context.Background()
+
+	var listSchema list.ListResourceSchemaResponse
+	l.ListResourceConfigSchema(ctx, list.ListResourceSchemaRequest{}, &listSchema)
+	var resourceSchema resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &resourceSchema)
+	var identitySchema resource.IdentitySchemaResponse
+	r.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &identitySchema)
+	require.False(t, listSchema.Diagnostics.HasError() || resourceSchema.Diagnostics.HasError() || identitySchema.Diagnostics.HasError())
+
+	objectType := listSchema.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, typ := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(typ, nil)
+	}
+	for name, value := range config {
+		values[name] = tftypes.NewValue(tftypes.String, value)
+	}
+
+	return list.ListRequest{
+		Config:                 tfsdk.Config{Schema: listSchema.Schema, Raw: tftypes.NewValue(objectType, values)},
+		IncludeResource:        includeResource,
+		ResourceSchema:         resourceSchema.Schema,
+		ResourceIdentitySchema: identitySchema.IdentitySchema,
+	}
+}
+
+func TestMonitorListResource_List(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/monitors", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(client.ListMonitorsResponse{
+			Status: "ok",
+			Data: &client.ListMonitorsData{Monitors: []client.Monitor{
+				{ID: "m1", Name: "Checkout API", Settings: client.MonitorSettings{HTTPS: &client.HTTPSSettings{URL: "https://shop.example.com/health"}}},
+				{ID: "m2", Name: "Checkout DB", Settings: client.MonitorSettings{TCP: &client.TCPSettings{URL: "tcp://db.example.com:5432"}}},
+				{ID: "m3", Name: "Website", Settings: client.MonitorSettings{HTTPS: &client.HTTPSSettings{URL: "https://example.com"}}},
+			}},
+		})
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	l := &MonitorListResource{client: client.NewClient(server.URL, "test-api-key")}
+
+	tests := []struct {
+		name    string
+		config  map[string]string
+		limit   int64
+		wantIDs []string
+	}{
+		{name: "all", wantIDs: []string{"m1", "m2", "m3"}},
+		{name: "name filter", config: map[string]string{"name": "checkout"}, wantIDs: []string{"m1", "m2"}},
+		{name: "name and type filter", config: map[string]string{"name": "checkout", "type": "https"}, wantIDs: []string{"m1"}},
+		{name: "limit", limit: 2, wantIDs: []string{"m1", "m2"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := listRequest(t, l, &MonitorResource{}, tt.config, true)
+			req.Limit = tt.limit
+
+			stream := &list.ListResultsStream{}
+			l.List(ctx, req, stream)
+
+			var ids []string
+			for result := range stream.Results {
+				require.False(t, result.Diagnostics.HasError(), "%v", result.Diagnostics)
+
+				var identity IDIdentityModel
+				require.False(t, result.Identity.Get(ctx, &identity).HasError())
+				ids = append(ids, identity.ID.ValueString())
+
+				var model MonitorResourceModel
+				require.False(t, result.Resource.Get(ctx, &model).HasError())
+				assert.Equal(t, identity.ID, model.ID)
+				assert.Equal(t, result.DisplayName, model.Name.ValueString())
+			}
+
+			assert.Equal(t, tt.wantIDs, ids)
+		})
+	}
+}
+
+func TestContactListResource_List(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/contacts", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(client.ListContactsResponse{
+			Status: "ok",
+			Data: &client.ListContactsData{Contacts: []client.Contact{
+				{ID: "c1", Name: "ops-alerts", Channel: "email", Details: json.RawMessage(`{"email":"ops@example.com"}`)},
+				{ID: "c2", Name: "ops-phone", Channel: "sms", Details: json.RawMessage(`{"phone":"+15551234567"}`)},
+			}},
+		})
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	l := &ContactListResource{client: client.NewClient(server.URL, "test-api-key")}
+
+	stream := &list.ListResultsStream{}
+	l.List(ctx, listRequest(t, l, &ContactResource{}, map[string]string{"channel": "email"}, true), stream)
+
+	var models []ContactResourceModel
+	for result := range stream.Results {
+		require.False(t, result.Diagnostics.HasError(), "%v", result.Diagnostics)
+
+		var model ContactResourceModel
+		require.False(t, result.Resource.Get(ctx, &model).HasError())
+		models = append(models, model)
+	}
+
+	require.Len(t, models, 1)
+	assert.Equal(t, "c1", models[0].ID.ValueString())
+	assert.False(t, models[0].EmailSettings.IsNull())
+	assert.True(t, models[0].SmsSettings.IsNull())
+}
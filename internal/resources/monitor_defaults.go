@@ -0,0 +1,63 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-uptime/internal/client"
+)
+
+// Built-in monitor defaults, used when neither the resource nor the
+// provider's monitor_defaults set a value
+const (
+	defaultCheckInterval = 60
+	defaultTimeout       = 30
+	defaultFailThreshold = 1
+)
+
+// applyMonitorDefaults sets every defaultable attribute that is null in the
+// configuration to the provider default, falling back to the built-in default.
+// Values set on the resource always win.
+func applyMonitorDefaults(ctx context.Context, config, plan *MonitorResourceModel, defaults client.MonitorDefaults) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if config.CheckInterval.IsNull() {
+		plan.CheckInterval = types.Int64Value(int64(intOrDefault(defaults.CheckInterval, defaultCheckInterval)))
+	}
+	if config.Timeout.IsNull() {
+		plan.Timeout = types.Int64Value(int64(intOrDefault(defaults.Timeout, defaultTimeout)))
+	}
+	if config.FailThreshold.IsNull() {
+		plan.FailThreshold = types.Int64Value(int64(intOrDefault(defaults.FailThreshold, defaultFailThreshold)))
+	}
+
+	if config.Regions.IsNull() {
+		regions, d := stringListOrNull(ctx, defaults.Regions)
+		diags.Append(d...)
+		plan.Regions = regions
+	}
+	if config.Contacts.IsNull() {
+		contacts, d := stringListOrNull(ctx, defaults.Contacts)
+		diags.Append(d...)
+		plan.Contacts = contacts
+	}
+
+	return diags
+}
+
+// intOrDefault returns value, or fallback when value is not set
+func intOrDefault(value, fallback int) int {
+	if value > 0 {
+		return value
+	}
+	return fallback
+}
+
+// stringListOrNull converts values to a list, or a null list when empty
+func stringListOrNull(ctx context.Context, values []string) (types.List, diag.Diagnostics) {
+	if len(values) == 0 {
+		return types.ListNull(types.StringType), nil
+	}
+	return types.ListValueFrom(ctx, types.StringType, values)
+}
@@ -0,0 +1,120 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-uptime/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ list.ListResource = &MonitorListResource{}
+var _ list.ListResourceWithConfigure = &MonitorListResource{}
+
+func NewMonitorListResource() list.ListResource {
+	return &MonitorListResource{}
+}
+
+// MonitorListResource lists existing monitors for `terraform query`.
+type MonitorListResource struct {
+	client *client.Client
+}
+
+// MonitorListResourceModel describes the list block configuration.
+type MonitorListResourceModel struct {
+	Name types.String `tfsdk:"name"`
+	Type types.String `tfsdk:"type"`
+}
+
+func (l *MonitorListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_monitor"
+}
+
+func (l *MonitorListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		MarkdownDescription: "Lists the monitors in the account, optionally filtered by name and type",
+
+		Attributes: map[string]listschema.Attribute{
+			"name": listschema.StringAttribute{
+				MarkdownDescription: "Only list monitors whose name contains this text, ignoring case",
+				Optional:            true,
+			},
+			"type": listschema.StringAttribute{
+				MarkdownDescription: "Only list monitors of this type: https, tcp, or ping",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("https", "tcp", "ping"),
+				},
+			},
+		},
+	}
+}
+
+func (l *MonitorListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	l.client = client
+}
+
+func (l *MonitorListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data MonitorListResourceModel
+
+	diags := req.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	monitors, err := l.client.ListMonitors()
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list monitors, got error: %s", err))
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	var matches []client.Monitor
+	for _, monitor := range monitors {
+		monitorType, _, _ := monitorTypeAndURL(&monitor)
+		if !nameMatches(data.Name, monitor.Name) || (!data.Type.IsNull() && monitorType != data.Type.ValueString()) {
+			continue
+		}
+		matches = append(matches, monitor)
+	}
+
+	stream.Results = listResults(ctx, req, matches, func(monitor client.Monitor, result *list.ListResult) {
+		result.DisplayName = monitor.Name
+		result.Diagnostics.Append(setIdentity(ctx, result.Identity, types.StringValue(monitor.ID))...)
+
+		if !req.IncludeResource {
+			return
+		}
+
+		model, diags := monitorModel(ctx, &monitor)
+		result.Diagnostics.Append(diags...)
+		if result.Diagnostics.HasError() {
+			return
+		}
+
+		result.Diagnostics.Append(result.Resource.Set(ctx, &model)...)
+	})
+}
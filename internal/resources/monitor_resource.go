@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"sort"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -11,7 +12,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -23,6 +23,8 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &MonitorResource{}
 var _ resource.ResourceWithImportState = &MonitorResource{}
+var _ resource.ResourceWithIdentity = &MonitorResource{}
+var _ resource.ResourceWithModifyPlan = &MonitorResource{}
 
 func NewMonitorResource() resource.Resource {
 	return &MonitorResource{}
@@ -51,6 +53,9 @@ type MonitorResourceModel struct {
 	// Certificate monitoring fields
 	Host types.String `tfsdk:"host"`
 	Port types.Int64  `tfsdk:"port"`
+
+	DeletionProtection types.Bool `tfsdk:"deletion_protection"`
+	ForceDetach        types.Bool `tfsdk:"force_detach"`
 }
 
 // HTTPSSettingsModel represents HTTPS-specific configuration
@@ -113,33 +118,34 @@ func (r *MonitorResource) Schema(ctx context.Context, req resource.SchemaRequest
 				Default:             booldefault.StaticBool(true),
 			},
 			"check_interval": schema.Int64Attribute{
-				MarkdownDescription: "Check interval in seconds",
+				MarkdownDescription: "Check interval in seconds. Defaults to the provider's `monitor_defaults`, or 60.",
 				Optional:            true,
 				Computed:            true,
-				Default:             int64default.StaticInt64(60),
 			},
 			"timeout": schema.Int64Attribute{
-				MarkdownDescription: "Request timeout in seconds",
+				MarkdownDescription: "Request timeout in seconds. Defaults to the provider's `monitor_defaults`, or 30.",
 				Optional:            true,
 				Computed:            true,
-				Default:             int64default.StaticInt64(30),
 			},
 			"fail_threshold": schema.Int64Attribute{
-				MarkdownDescription: "Number of consecutive failed checks before marking monitor as down. Must not exceed the number of regions.",
+				MarkdownDescription: "Number of consecutive failed checks before marking monitor as down. Must not exceed the number of regions. Defaults to the provider's `monitor_defaults`, or 1.",
 				Optional:            true,
 				Computed:            true,
-				Default:             int64default.StaticInt64(1),
 			},
 			"regions": schema.ListAttribute{
-				MarkdownDescription: "List of regions to perform checks from",
+				MarkdownDescription: "List of regions to perform checks from. See the `uptime_regions` data source for valid values. Defaults to the provider's `monitor_defaults`.",
 				ElementType:         types.StringType,
 				Optional:            true,
+				Computed:            true,
 			},
 			"contacts": schema.ListAttribute{
-				MarkdownDescription: "List of contact IDs to notify when monitor status changes",
+				MarkdownDescription: "List of contact IDs to notify when monitor status changes. Defaults to the provider's `monitor_defaults`.",
 				ElementType:         types.StringType,
 				Optional:            true,
+				Computed:            true,
 			},
+			"deletion_protection": deletionProtectionAttribute("monitor"),
+			"force_detach":        forceDetachAttribute("monitor", "status pages"),
 			"https_settings": schema.SingleNestedAttribute{
 				MarkdownDescription: "HTTPS-specific configuration (only applicable when type is 'https')",
 				Optional:            true,
@@ -214,6 +220,10 @@ func (r *MonitorResource) Schema(ctx context.Context, req resource.SchemaRequest
 	}
 }
 
+func (r *MonitorResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("monitor")
+}
+
 func (r *MonitorResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
@@ -234,6 +244,51 @@ func (r *MonitorResource) Configure(ctx context.Context, req resource.ConfigureR
 	r.client = client
 }
 
+func (r *MonitorResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Skip validation during resource destruction
+	if req.Plan.Raw.IsNull() {
+		checkReadOnly(r.client, "monitor", req, resp)
+		return
+	}
+
+	var data, config MonitorResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Fill attributes left unset in configuration with their defaults. The
+	// provider's monitor_defaults are not known until it is configured, or
+	// while they refer to values not known yet, so until then those
+	// attributes are left unknown.
+	if r.client == nil {
+		return
+	}
+	if !r.client.MonitorDefaults.Unknown {
+		resp.Diagnostics.Append(applyMonitorDefaults(ctx, &config, &data, r.client.MonitorDefaults)...)
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &data)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Provider defaults can change a monitor whose configuration did not,
+	// so the plan is only checked once they are applied
+	checkReadOnly(r.client, "monitor", req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.validateRegions(ctx, &data, resp)
+	r.validateContacts(ctx, &data, resp)
+
+	// Only creations count towards the monitor quota
+	if req.State.Raw.IsNull() {
+		r.validateQuota(resp)
+	}
+}
+
 func (r *MonitorResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data MonitorResourceModel
 
@@ -267,6 +322,7 @@ func (r *MonitorResource) Create(ctx context.Context, req resource.CreateRequest
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, data.ID)...)
 }
 
 func (r *MonitorResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -298,9 +354,12 @@ func (r *MonitorResource) Read(ctx context.Context, req resource.ReadRequest, re
 		resp.Diagnostics.AddError("Data Conversion Error", fmt.Sprintf("Unable to convert API response: %s", err))
 		return
 	}
+	data.DeletionProtection = stateFlagValue(data.DeletionProtection)
+	data.ForceDetach = stateFlagValue(data.ForceDetach)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, data.ID)...)
 }
 
 func (r *MonitorResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -336,6 +395,7 @@ func (r *MonitorResource) Update(ctx context.Context, req resource.UpdateRequest
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, data.ID)...)
 }
 
 func (r *MonitorResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -348,6 +408,16 @@ func (r *MonitorResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
+	checkDeletionProtection(data.DeletionProtection, "monitor", data.ID.ValueString(), resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.detachFromStatusPages(&data, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Delete monitor via API
 	err := r.client.DeleteMonitor(data.ID.ValueString())
 	if err != nil {
@@ -356,8 +426,117 @@ func (r *MonitorResource) Delete(ctx context.Context, req resource.DeleteRequest
 	}
 }
 
+// detachFromStatusPages removes the monitor from the status pages that still
+// show it when force_detach is set, and fails the deletion otherwise
+func (r *MonitorResource) detachFromStatusPages(data *MonitorResourceModel, resp *resource.DeleteResponse) {
+	id := data.ID.ValueString()
+
+	statusPages, err := r.client.StatusPagesShowingMonitor(id)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find status pages showing monitor: %s", err))
+		return
+	}
+	if len(statusPages) == 0 {
+		return
+	}
+
+	names := make([]string, 0, len(statusPages))
+	var onlyMonitor []string
+	for _, statusPage := range statusPages {
+		names = append(names, describeObject(statusPage.Name, statusPage.ID))
+		if len(withoutID(statusPage.Monitors, id)) == 0 {
+			onlyMonitor = append(onlyMonitor, describeObject(statusPage.Name, statusPage.ID))
+		}
+	}
+
+	if !data.ForceDetach.ValueBool() {
+		resp.Diagnostics.AddError(
+			"Monitor In Use",
+			fmt.Sprintf("Monitor %s is still shown on these status pages: %s. "+
+				"Remove it from them first, or set force_detach = true and apply before deleting the monitor.", id, strings.Join(names, ", ")),
+		)
+		return
+	}
+
+	// A status page needs at least one monitor, so it cannot be detached
+	// from pages that show nothing else
+	if len(onlyMonitor) > 0 {
+		resp.Diagnostics.AddError(
+			"Monitor In Use",
+			fmt.Sprintf("Monitor %s is the only monitor on these status pages: %s. "+
+				"Delete them or add another monitor to them before deleting the monitor.", id, strings.Join(onlyMonitor, ", ")),
+		)
+		return
+	}
+
+	for _, statusPage := range statusPages {
+		if err := r.client.SetStatusPageMonitors(statusPage.ID, withoutID(statusPage.Monitors, id)); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove monitor from status page %s: %s", statusPage.ID, err))
+			return
+		}
+	}
+}
+
+// ImportState accepts a monitor ID, an identity or a natural key made of
+// name, url and type, e.g. `name=Checkout API` or
+// `url=https://shop.example.com/health`
 func (r *MonitorResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	key, err := parseImportID(req.ID, "name", "url", "type")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Unable to parse import ID %q: %s", req.ID, err))
+		return
+	}
+	if key == nil {
+		resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+		return
+	}
+
+	monitors, err := r.client.ListMonitors()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list monitors, got error: %s", err))
+		return
+	}
+
+	var matches []importMatch
+	for _, monitor := range monitors {
+		if monitorMatchesImportKey(&monitor, key) {
+			matches = append(matches, importMatch{ID: monitor.ID, Name: monitor.Name})
+		}
+	}
+
+	id, err := resolveImportMatch("monitor", key, matches)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Import Monitor", fmt.Sprintf("Cannot resolve import ID %q: %s", req.ID, err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, types.StringValue(id))...)
+}
+
+// monitorMatchesImportKey reports whether a monitor matches every key of a
+// natural-key import ID
+func monitorMatchesImportKey(monitor *client.Monitor, key importKey) bool {
+	monitorType, monitorURL, _ := monitorTypeAndURL(monitor)
+
+	for k, v := range key {
+		switch k {
+		case "name":
+			if monitor.Name != v {
+				return false
+			}
+		case "type":
+			if monitorType != v {
+				return false
+			}
+		case "url":
+			if NormalizeURL(monitorURL) != NormalizeURL(strings.TrimPrefix(v, "ping://")) {
+				return false
+			}
+		}
+	}
+
+	return true
 }
 
 // Helper functions for data conversion
@@ -432,11 +611,7 @@ func (r *MonitorResource) modelToCreateRequest(ctx context.Context, data *Monito
 			if !tfHttpsSettings.RequestHeaders.IsNull() {
 				var headers map[string]string
 				tfHttpsSettings.RequestHeaders.ElementsAs(ctx, &headers, false)
-				var headerStrings []string
-				for k, v := range headers {
-					headerStrings = append(headerStrings, fmt.Sprintf("%s: %s", k, v))
-				}
-				headerStr := strings.Join(headerStrings, "\n")
+				headerStr := FormatHeaders(headers)
 				httpsSettings.RequestHeaders = &headerStr
 			}
 
@@ -453,11 +628,7 @@ func (r *MonitorResource) modelToCreateRequest(ctx context.Context, data *Monito
 			if !tfHttpsSettings.ExpectedResponseHeaders.IsNull() {
 				var headers map[string]string
 				tfHttpsSettings.ExpectedResponseHeaders.ElementsAs(ctx, &headers, false)
-				var headerStrings []string
-				for k, v := range headers {
-					headerStrings = append(headerStrings, fmt.Sprintf("%s: %s", k, v))
-				}
-				headerStr := strings.Join(headerStrings, "\n")
+				headerStr := FormatHeaders(headers)
 				httpsSettings.ResponseHeaders = &headerStr
 			}
 		}
@@ -470,13 +641,8 @@ func (r *MonitorResource) modelToCreateRequest(ctx context.Context, data *Monito
 		}
 
 	case "ping":
-		// For ping monitors, the API expects a URL with ping:// scheme
-		pingURL := url
-		if !strings.HasPrefix(url, "ping://") {
-			pingURL = "ping://" + url
-		}
 		req.Settings.Ping = &client.PingSettings{
-			URL: pingURL,
+			URL: PingURL(url),
 		}
 
 	default:
@@ -584,11 +750,7 @@ func (r *MonitorResource) modelToUpdateRequest(ctx context.Context, data *Monito
 			if !tfHttpsSettings.RequestHeaders.IsNull() {
 				var headers map[string]string
 				tfHttpsSettings.RequestHeaders.ElementsAs(ctx, &headers, false)
-				var headerStrings []string
-				for k, v := range headers {
-					headerStrings = append(headerStrings, fmt.Sprintf("%s: %s", k, v))
-				}
-				headerStr := strings.Join(headerStrings, "\n")
+				headerStr := FormatHeaders(headers)
 				httpsSettings.RequestHeaders = &headerStr
 			}
 
@@ -605,11 +767,7 @@ func (r *MonitorResource) modelToUpdateRequest(ctx context.Context, data *Monito
 			if !tfHttpsSettings.ExpectedResponseHeaders.IsNull() {
 				var headers map[string]string
 				tfHttpsSettings.ExpectedResponseHeaders.ElementsAs(ctx, &headers, false)
-				var headerStrings []string
-				for k, v := range headers {
-					headerStrings = append(headerStrings, fmt.Sprintf("%s: %s", k, v))
-				}
-				headerStr := strings.Join(headerStrings, "\n")
+				headerStr := FormatHeaders(headers)
 				httpsSettings.ResponseHeaders = &headerStr
 			}
 		}
@@ -622,14 +780,8 @@ func (r *MonitorResource) modelToUpdateRequest(ctx context.Context, data *Monito
 		}
 
 	case "ping":
-		// For ping monitors, the API expects a URL with ping:// scheme
-		url := data.URL.ValueString()
-		pingURL := url
-		if !strings.HasPrefix(url, "ping://") {
-			pingURL = "ping://" + url
-		}
 		settings.Ping = &client.PingSettings{
-			URL: pingURL,
+			URL: PingURL(data.URL.ValueString()),
 		}
 	}
 
@@ -660,22 +812,12 @@ func (r *MonitorResource) apiModelToTerraformModel(ctx context.Context, monitor
 	data.FailThreshold = types.Int64Value(int64(monitor.FailThreshold))
 
 	// Determine type and URL from settings
-	if monitor.Settings.HTTPS != nil {
-		data.Type = types.StringValue("https")
-		// Normalize URL to match configuration expectations
-		normalizedURL := normalizeURL(monitor.Settings.HTTPS.URL)
-		data.URL = types.StringValue(normalizedURL)
-	} else if monitor.Settings.TCP != nil {
-		data.Type = types.StringValue("tcp")
-		data.URL = types.StringValue(monitor.Settings.TCP.URL)
-	} else if monitor.Settings.Ping != nil {
-		data.Type = types.StringValue("ping")
-		// Strip ping:// prefix for Terraform state consistency
-		pingURL := strings.TrimPrefix(monitor.Settings.Ping.URL, "ping://")
-		data.URL = types.StringValue(pingURL)
-	} else {
+	monitorType, monitorURL, ok := monitorTypeAndURL(monitor)
+	if !ok {
 		return fmt.Errorf("monitor has no recognized settings type")
 	}
+	data.Type = types.StringValue(monitorType)
+	data.URL = types.StringValue(monitorURL)
 
 	// Handle regions
 	if len(monitor.Regions) > 0 {
@@ -723,19 +865,7 @@ func (r *MonitorResource) apiModelToTerraformModel(ctx context.Context, monitor
 
 		// Convert headers string to Terraform map
 		if monitor.Settings.HTTPS.RequestHeaders != nil && *monitor.Settings.HTTPS.RequestHeaders != "" {
-			headersMap := make(map[string]types.String)
-			// Parse "Header1: Value1\nHeader2: Value2" format
-			lines := strings.Split(*monitor.Settings.HTTPS.RequestHeaders, "\n")
-			for _, line := range lines {
-				if strings.Contains(line, ":") {
-					parts := strings.SplitN(line, ":", 2)
-					if len(parts) == 2 {
-						key := strings.TrimSpace(parts[0])
-						value := strings.TrimSpace(parts[1])
-						headersMap[key] = types.StringValue(value)
-					}
-				}
-			}
+			headersMap := ParseHeaders(*monitor.Settings.HTTPS.RequestHeaders)
 			headersTerraformMap, _ := types.MapValueFrom(ctx, types.StringType, headersMap)
 			httpsSettings.RequestHeaders = headersTerraformMap
 		} else {
@@ -755,19 +885,7 @@ func (r *MonitorResource) apiModelToTerraformModel(ctx context.Context, monitor
 		}
 
 		if monitor.Settings.HTTPS.ResponseHeaders != nil && *monitor.Settings.HTTPS.ResponseHeaders != "" {
-			headersMap := make(map[string]types.String)
-			// Parse "Header1: Value1\nHeader2: Value2" format
-			lines := strings.Split(*monitor.Settings.HTTPS.ResponseHeaders, "\n")
-			for _, line := range lines {
-				if strings.Contains(line, ":") {
-					parts := strings.SplitN(line, ":", 2)
-					if len(parts) == 2 {
-						key := strings.TrimSpace(parts[0])
-						value := strings.TrimSpace(parts[1])
-						headersMap[key] = types.StringValue(value)
-					}
-				}
-			}
+			headersMap := ParseHeaders(*monitor.Settings.HTTPS.ResponseHeaders)
 			headersTerraformMap, _ := types.MapValueFrom(ctx, types.StringType, headersMap)
 			httpsSettings.ExpectedResponseHeaders = headersTerraformMap
 		} else {
@@ -840,9 +958,26 @@ func (r *MonitorResource) apiModelToTerraformModel(ctx context.Context, monitor
 	return nil
 }
 
-// normalizeURL removes trailing slashes from URLs to ensure consistent state
+// monitorTypeAndURL returns the type of a monitor and its URL as stored in
+// Terraform state, or false if the monitor has no recognized settings type
+func monitorTypeAndURL(monitor *client.Monitor) (string, string, bool) {
+	switch {
+	case monitor.Settings.HTTPS != nil:
+		// Normalize URL to match configuration expectations
+		return "https", NormalizeURL(monitor.Settings.HTTPS.URL), true
+	case monitor.Settings.TCP != nil:
+		return "tcp", monitor.Settings.TCP.URL, true
+	case monitor.Settings.Ping != nil:
+		// Strip ping:// prefix for Terraform state consistency
+		return "ping", strings.TrimPrefix(monitor.Settings.Ping.URL, "ping://"), true
+	default:
+		return "", "", false
+	}
+}
+
+// NormalizeURL removes trailing slashes from URLs to ensure consistent state
 // The API may add trailing slashes, but Terraform configurations typically don't include them
-func normalizeURL(rawURL string) string {
+func NormalizeURL(rawURL string) string {
 	if rawURL == "" {
 		return rawURL
 	}
@@ -864,3 +999,41 @@ func normalizeURL(rawURL string) string {
 
 	return parsedURL.String()
 }
+
+// PingURL adds the ping:// scheme the API expects for ping monitors, unless
+// the target already carries it
+func PingURL(host string) string {
+	if strings.HasPrefix(host, "ping://") {
+		return host
+	}
+	return "ping://" + host
+}
+
+// FormatHeaders converts headers to the "Header1: Value1\nHeader2: Value2"
+// wire format, sorted by header name so the result is stable
+func FormatHeaders(headers map[string]string) string {
+	keys := make([]string, 0, len(headers))
+	for k := range headers {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	headerStrings := make([]string, 0, len(keys))
+	for _, k := range keys {
+		headerStrings = append(headerStrings, fmt.Sprintf("%s: %s", k, headers[k]))
+	}
+	return strings.Join(headerStrings, "\n")
+}
+
+// ParseHeaders parses the "Header1: Value1\nHeader2: Value2" wire format,
+// skipping lines without a colon
+func ParseHeaders(raw string) map[string]string {
+	headers := make(map[string]string)
+	for _, line := range strings.Split(raw, "\n") {
+		parts := strings.SplitN(line, ":", 2)
+		if len(parts) == 2 {
+			headers[strings.TrimSpace(parts[0])] = strings.TrimSpace(parts[1])
+		}
+	}
+	return headers
+}
@@ -2,11 +2,16 @@ package resources
 
 import (
 	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"terraform-provider-uptime/internal/client"
@@ -235,7 +240,7 @@ func TestNormalizeURL(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			url := normalizeURL(tt.inputURL)
+			url := NormalizeURL(tt.inputURL)
 			assert.Equal(t, tt.expectedURL, url, "URL mismatch")
 		})
 	}
@@ -352,3 +357,306 @@ func TestMonitorResource_DefaultHTTPMethod(t *testing.T) {
 	assert.NotNil(t, req.Settings.HTTPS)
 	assert.Nil(t, req.Settings.HTTPS.HTTPMethod, "HTTP method should be nil when not specified")
 }
+
+func TestCheckRegions(t *testing.T) {
+	available := []client.Region{
+		{ID: "us-east-1", Name: "US East", Available: true},
+		{ID: "eu-west-1", Name: "EU West", Available: true},
+		{ID: "ap-south-1", Name: "Asia Pacific South", Available: false},
+	}
+
+	tests := []struct {
+		name       string
+		regions    []types.String
+		wantErrors []string
+	}{
+		{
+			name:    "all regions valid",
+			regions: []types.String{types.StringValue("us-east-1"), types.StringValue("eu-west-1")},
+		},
+		{
+			name:       "unknown region",
+			regions:    []types.String{types.StringValue("us-east-1"), types.StringValue("us-eats-1")},
+			wantErrors: []string{"Unknown Region"},
+		},
+		{
+			name:       "region not on plan",
+			regions:    []types.String{types.StringValue("ap-south-1")},
+			wantErrors: []string{"Region Not Available"},
+		},
+		{
+			name:    "unknown values are skipped",
+			regions: []types.String{types.StringUnknown()},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := checkRegions(tt.regions, available)
+
+			var summaries []string
+			for _, d := range diags.Errors() {
+				summaries = append(summaries, d.Summary())
+			}
+			assert.Equal(t, tt.wantErrors, summaries)
+		})
+	}
+}
+
+func TestCheckQuota(t *testing.T) {
+	account := &client.Account{CurrentPlan: "10-monthly", MonitorsLimit: 10, MonitorsCount: 8}
+
+	tests := []struct {
+		name         string
+		account      *client.Account
+		planned      int
+		enforce      bool
+		wantWarnings int
+		wantErrors   int
+	}{
+		{
+			name:    "within limit",
+			account: account,
+			planned: 2,
+		},
+		{
+			name:         "over limit warns",
+			account:      account,
+			planned:      3,
+			wantWarnings: 1,
+		},
+		{
+			name:       "over limit errors when enforced",
+			account:    account,
+			planned:    3,
+			enforce:    true,
+			wantErrors: 1,
+		},
+		{
+			name:    "no limit",
+			account: &client.Account{MonitorsCount: 500},
+			planned: 100,
+			enforce: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := checkQuota(tt.account, tt.planned, tt.enforce)
+
+			assert.Len(t, diags.Warnings(), tt.wantWarnings)
+			assert.Len(t, diags.Errors(), tt.wantErrors)
+		})
+	}
+}
+
+func TestApplyMonitorDefaults(t *testing.T) {
+	ctx := context.Background()
+	regions, _ := types.ListValueFrom(ctx, types.StringType, []string{"eu-west-1"})
+
+	tests := []struct {
+		name              string
+		config            MonitorResourceModel
+		defaults          client.MonitorDefaults
+		wantCheckInterval int64
+		wantTimeout       int64
+		wantFailThreshold int64
+		wantRegions       []string
+		wantContacts      []string
+	}{
+		{
+			name: "built-in defaults",
+			config: MonitorResourceModel{
+				CheckInterval: types.Int64Null(),
+				Timeout:       types.Int64Null(),
+				FailThreshold: types.Int64Null(),
+				Regions:       types.ListNull(types.StringType),
+				Contacts:      types.ListNull(types.StringType),
+			},
+			wantCheckInterval: 60,
+			wantTimeout:       30,
+			wantFailThreshold: 1,
+		},
+		{
+			name: "provider defaults",
+			config: MonitorResourceModel{
+				CheckInterval: types.Int64Null(),
+				Timeout:       types.Int64Null(),
+				FailThreshold: types.Int64Null(),
+				Regions:       types.ListNull(types.StringType),
+				Contacts:      types.ListNull(types.StringType),
+			},
+			defaults: client.MonitorDefaults{
+				Regions:       []string{"us-east-1", "eu-west-1"},
+				Contacts:      []string{"contact-1"},
+				CheckInterval: 30,
+				FailThreshold: 2,
+			},
+			wantCheckInterval: 30,
+			wantTimeout:       30,
+			wantFailThreshold: 2,
+			wantRegions:       []string{"us-east-1", "eu-west-1"},
+			wantContacts:      []string{"contact-1"},
+		},
+		{
+			name: "resource values win",
+			config: MonitorResourceModel{
+				CheckInterval: types.Int64Value(300),
+				Timeout:       types.Int64Value(10),
+				FailThreshold: types.Int64Value(1),
+				Regions:       regions,
+				Contacts:      types.ListNull(types.StringType),
+			},
+			defaults: client.MonitorDefaults{
+				Regions:       []string{"us-east-1", "eu-west-1"},
+				CheckInterval: 30,
+				Timeout:       20,
+				FailThreshold: 2,
+			},
+			wantCheckInterval: 300,
+			wantTimeout:       10,
+			wantFailThreshold: 1,
+			wantRegions:       []string{"eu-west-1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan := tt.config
+
+			diags := applyMonitorDefaults(ctx, &tt.config, &plan, tt.defaults)
+			require.False(t, diags.HasError())
+
+			assert.Equal(t, tt.wantCheckInterval, plan.CheckInterval.ValueInt64())
+			assert.Equal(t, tt.wantTimeout, plan.Timeout.ValueInt64())
+			assert.Equal(t, tt.wantFailThreshold, plan.FailThreshold.ValueInt64())
+
+			var gotRegions, gotContacts []string
+			plan.Regions.ElementsAs(ctx, &gotRegions, false)
+			plan.Contacts.ElementsAs(ctx, &gotContacts, false)
+			assert.Equal(t, tt.wantRegions, gotRegions)
+			assert.Equal(t, tt.wantContacts, gotContacts)
+		})
+	}
+}
+
+func TestMonitorResource_ModifyPlan_ReadOnlyDefaultsChange(t *testing.T) {
+	ctx := context.Background()
+
+	state, diags := MonitorState(ctx, &client.Monitor{
+		ID: "monitor123", Name: "Checkout API", Active: true,
+		CheckInterval: 60, Timeout: 30, FailThreshold: 1,
+		Settings: client.MonitorSettings{HTTPS: &client.HTTPSSettings{URL: "https://example.com"}},
+	})
+	require.False(t, diags.HasError(), diags)
+
+	// The configuration leaves check_interval unset, so the new provider
+	// default is the only change
+	var config MonitorResourceModel
+	require.False(t, state.Get(ctx, &config).HasError())
+	config.CheckInterval = types.Int64Null()
+	configState := tfsdk.State{Schema: state.Schema}
+	require.False(t, configState.Set(ctx, &config).HasError())
+
+	req := resource.ModifyPlanRequest{
+		State:  state,
+		Plan:   tfsdk.Plan{Schema: state.Schema, Raw: state.Raw},
+		Config: tfsdk.Config{Schema: state.Schema, Raw: configState.Raw},
+	}
+
+	c := client.NewClient("http://127.0.0.1:0", "key")
+	c.ReadOnly = true
+	c.MonitorDefaults = client.MonitorDefaults{CheckInterval: 30}
+	r := &MonitorResource{client: c}
+
+	resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+	r.ModifyPlan(ctx, req, resp)
+	require.True(t, resp.Diagnostics.HasError())
+	assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), "would update a monitor")
+
+	// Without a default that differs from state, nothing changes
+	c.MonitorDefaults = client.MonitorDefaults{}
+	resp = &resource.ModifyPlanResponse{Plan: req.Plan}
+	r.ModifyPlan(ctx, req, resp)
+	assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+}
+
+func TestMonitorResource_ModifyPlan_UnconfiguredProvider(t *testing.T) {
+	ctx := context.Background()
+
+	planned, diags := MonitorState(ctx, &client.Monitor{
+		Name:     "Checkout API",
+		Active:   true,
+		Settings: client.MonitorSettings{HTTPS: &client.HTTPSSettings{URL: "https://example.com"}},
+	})
+	require.False(t, diags.HasError(), diags)
+
+	// A new monitor leaving the defaultable attributes unset, as planned
+	// before the provider's monitor_defaults are known
+	var data MonitorResourceModel
+	require.False(t, planned.Get(ctx, &data).HasError())
+	data.CheckInterval = types.Int64Unknown()
+	data.Timeout = types.Int64Unknown()
+	data.FailThreshold = types.Int64Unknown()
+	require.False(t, planned.Set(ctx, &data).HasError())
+
+	req := resource.ModifyPlanRequest{
+		State:  tfsdk.State{Schema: planned.Schema, Raw: tftypes.NewValue(planned.Raw.Type(), nil)},
+		Plan:   tfsdk.Plan{Schema: planned.Schema, Raw: planned.Raw},
+		Config: tfsdk.Config{Schema: planned.Schema, Raw: planned.Raw},
+	}
+	resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+	(&MonitorResource{}).ModifyPlan(ctx, req, resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	var got MonitorResourceModel
+	require.False(t, resp.Plan.Get(ctx, &got).HasError())
+	assert.True(t, got.CheckInterval.IsUnknown())
+	assert.True(t, got.Timeout.IsUnknown())
+	assert.True(t, got.FailThreshold.IsUnknown())
+}
+
+func TestMonitorResource_ModifyPlan_QuotaSameName(t *testing.T) {
+	ctx := context.Background()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" || r.URL.Path != "/api/account" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(client.AccountResponse{
+			Status: "ok",
+			Data:   &client.Account{ID: "account123", MonitorsLimit: 2, MonitorsCount: 1},
+		})
+	}))
+	defer server.Close()
+
+	planned, diags := MonitorState(ctx, &client.Monitor{
+		Name: "Checkout API", Active: true,
+		CheckInterval: 60, Timeout: 30, FailThreshold: 1,
+		Settings: client.MonitorSettings{HTTPS: &client.HTTPSSettings{URL: "https://example.com"}},
+	})
+	require.False(t, diags.HasError(), diags)
+
+	req := resource.ModifyPlanRequest{
+		State:  tfsdk.State{Schema: planned.Schema, Raw: tftypes.NewValue(planned.Raw.Type(), nil)},
+		Plan:   tfsdk.Plan{Schema: planned.Schema, Raw: planned.Raw},
+		Config: tfsdk.Config{Schema: planned.Schema, Raw: planned.Raw},
+	}
+
+	c := client.NewClient(server.URL, "key")
+	c.EnforceQuota = true
+	r := &MonitorResource{client: c}
+
+	// Names need not be unique, e.g. with count or in separate modules, so
+	// two monitors with the same name both count towards the limit
+	resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+	r.ModifyPlan(ctx, req, resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	resp = &resource.ModifyPlanResponse{Plan: req.Plan}
+	r.ModifyPlan(ctx, req, resp)
+	require.True(t, resp.Diagnostics.HasError())
+	assert.Equal(t, "Monitor Limit Exceeded", resp.Diagnostics.Errors()[0].Summary())
+}
@@ -0,0 +1,154 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-uptime/internal/client"
+)
+
+// validateRegions checks the planned regions against the regions offered by the service
+func (r *MonitorResource) validateRegions(ctx context.Context, data *MonitorResourceModel, resp *resource.ModifyPlanResponse) {
+	// Nothing to check until the provider is configured and regions are known
+	if r.client == nil || data.Regions.IsNull() || data.Regions.IsUnknown() {
+		return
+	}
+
+	var regions []types.String
+	resp.Diagnostics.Append(data.Regions.ElementsAs(ctx, &regions, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	available, err := r.client.ListRegionsCached()
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Unable to Validate Regions",
+			fmt.Sprintf("Could not fetch the list of available regions, so regions will only be checked by the API during apply: %s", err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(checkRegions(regions, available)...)
+}
+
+// checkRegions reports an attribute error for every region that is unknown
+// to the service or not available on the current plan
+func checkRegions(regions []types.String, available []client.Region) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	byID := make(map[string]client.Region, len(available))
+	var usable []string
+	for _, region := range available {
+		byID[region.ID] = region
+		if region.Available {
+			usable = append(usable, region.ID)
+		}
+	}
+	sort.Strings(usable)
+
+	for i, region := range regions {
+		if region.IsNull() || region.IsUnknown() {
+			continue
+		}
+
+		id := region.ValueString()
+		known, ok := byID[id]
+		switch {
+		case !ok:
+			diags.AddAttributeError(
+				path.Root("regions").AtListIndex(i),
+				"Unknown Region",
+				fmt.Sprintf("Region %q does not exist. Available regions are: %s", id, strings.Join(usable, ", ")),
+			)
+		case !known.Available:
+			diags.AddAttributeError(
+				path.Root("regions").AtListIndex(i),
+				"Region Not Available",
+				fmt.Sprintf("Region %q is not available on the current plan. Available regions are: %s", id, strings.Join(usable, ", ")),
+			)
+		}
+	}
+
+	return diags
+}
+
+// validateContacts checks that the planned contacts exist in the account
+func (r *MonitorResource) validateContacts(ctx context.Context, data *MonitorResourceModel, resp *resource.ModifyPlanResponse) {
+	if r.client == nil || data.Contacts.IsNull() || data.Contacts.IsUnknown() {
+		return
+	}
+
+	var ids []types.String
+	resp.Diagnostics.Append(data.Contacts.ElementsAs(ctx, &ids, false)...)
+	if resp.Diagnostics.HasError() || len(ids) == 0 {
+		return
+	}
+
+	contacts, err := r.client.ListContactsCached()
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Unable to Validate Contacts",
+			fmt.Sprintf("Could not fetch the list of contacts, so contacts will only be checked by the API during apply: %s", err),
+		)
+		return
+	}
+
+	existing := make(map[string]bool, len(contacts))
+	for _, contact := range contacts {
+		existing[contact.ID] = true
+	}
+
+	resp.Diagnostics.Append(checkReferences(path.Root("contacts"), ids, existing, "contact")...)
+}
+
+// validateQuota records a planned monitor creation and checks that the
+// account's monitor limit will not be exceeded by the monitors planned so far
+func (r *MonitorResource) validateQuota(resp *resource.ModifyPlanResponse) {
+	if r.client == nil {
+		return
+	}
+
+	account, err := r.client.GetAccountCached()
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Unable to Validate Monitor Quota",
+			fmt.Sprintf("Could not fetch the account, so the monitor limit will only be checked by the API during apply: %s", err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(checkQuota(account, r.client.PlanMonitorCreation(), r.client.EnforceQuota)...)
+}
+
+// checkQuota reports a warning, or an error when enforce is set, if the
+// planned creations push the account over its monitor limit
+func checkQuota(account *client.Account, planned int, enforce bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// A limit of zero means the plan does not cap the number of monitors
+	if account.MonitorsLimit <= 0 || account.MonitorsCount+planned <= account.MonitorsLimit {
+		return diags
+	}
+
+	summary := "Monitor Limit Exceeded"
+	detail := fmt.Sprintf(
+		"The account has %d of %d monitors and this plan creates at least %d more, so creating this monitor would exceed the limit of the %q plan. "+
+			"Remove monitors or upgrade the plan before applying.",
+		account.MonitorsCount, account.MonitorsLimit, planned, account.CurrentPlan,
+	)
+
+	if enforce {
+		diags.AddError(summary, detail)
+	} else {
+		diags.AddWarning(summary, detail+" Set enforce_quota = true on the provider to make this an error.")
+	}
+
+	return diags
+}
@@ -0,0 +1,45 @@
+package resources
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"terraform-provider-uptime/internal/client"
+)
+
+// plannedAction returns the change a plan makes to a resource: "create",
+// "update" or "delete", or "" if the plan leaves it unchanged
+func plannedAction(state tfsdk.State, plan tfsdk.Plan) string {
+	switch {
+	case plan.Raw.IsNull():
+		return "delete"
+	case state.Raw.IsNull():
+		return "create"
+	case !plan.Raw.Equal(state.Raw):
+		return "update"
+	default:
+		return ""
+	}
+}
+
+// checkReadOnly fails the plan if it changes a resource while the provider
+// is configured with read_only. It compares the prior state with the plan
+// in resp, so it must run after every change ModifyPlan makes to the plan.
+// kind names the resource in the diagnostic, e.g. "monitor".
+func checkReadOnly(c *client.Client, kind string, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if c == nil || !c.ReadOnly {
+		return
+	}
+
+	action := plannedAction(req.State, resp.Plan)
+	if action == "" {
+		return
+	}
+
+	resp.Diagnostics.AddError(
+		"Provider Is Read-Only",
+		fmt.Sprintf("This plan would %s a %s, but the provider is configured with read_only = true. "+
+			"Remove read_only from the provider configuration to make changes.", action, kind),
+	)
+}
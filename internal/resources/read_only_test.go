@@ -0,0 +1,49 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"terraform-provider-uptime/internal/client"
+)
+
+func TestCheckReadOnly(t *testing.T) {
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"name": tftypes.String}}
+	object := func(name string) tftypes.Value {
+		return tftypes.NewValue(objectType, map[string]tftypes.Value{"name": tftypes.NewValue(tftypes.String, name)})
+	}
+	null := tftypes.NewValue(objectType, nil)
+
+	tests := []struct {
+		name       string
+		state      tftypes.Value
+		plan       tftypes.Value
+		wantAction string
+	}{
+		{name: "create", state: null, plan: object("a"), wantAction: "create"},
+		{name: "update", state: object("a"), plan: object("b"), wantAction: "update"},
+		{name: "delete", state: object("a"), plan: null, wantAction: "delete"},
+		{name: "no change", state: object("a"), plan: object("a"), wantAction: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := resource.ModifyPlanRequest{
+				State: tfsdk.State{Raw: tt.state},
+				Plan:  tfsdk.Plan{Raw: tt.plan},
+			}
+			assert.Equal(t, tt.wantAction, plannedAction(req.State, req.Plan))
+
+			resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+			checkReadOnly(&client.Client{ReadOnly: true}, "monitor", req, resp)
+			assert.Equal(t, tt.wantAction != "", resp.Diagnostics.HasError())
+
+			resp = &resource.ModifyPlanResponse{Plan: req.Plan}
+			checkReadOnly(&client.Client{}, "monitor", req, resp)
+			assert.False(t, resp.Diagnostics.HasError())
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package resources
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// checkReferences reports an attribute error for every known ID in ids that
+// is not in existing. attribute is the list attribute holding the IDs and
+// kind names what they refer to, e.g. "contact".
+func checkReferences(attribute path.Path, ids []types.String, existing map[string]bool, kind string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for i, id := range ids {
+		// IDs of objects created in the same run are unknown until apply
+		if id.IsNull() || id.IsUnknown() || existing[id.ValueString()] {
+			continue
+		}
+
+		diags.AddAttributeError(
+			attribute.AtListIndex(i),
+			"Unknown "+strings.ToUpper(kind[:1])+kind[1:],
+			fmt.Sprintf("No %s with ID %q exists in the account. Check for a typo, or whether it was deleted outside Terraform.", kind, id.ValueString()),
+		)
+	}
+
+	return diags
+}
+
+// forceDetachAttribute returns the force_detach attribute of a resource.
+// kind names the resource and holders what references it, e.g. "contact"
+// and "monitors".
+func forceDetachAttribute(kind, holders string) schema.BoolAttribute {
+	return schema.BoolAttribute{
+		MarkdownDescription: fmt.Sprintf("Remove the %s from all %s that still reference it before deleting it. When `false`, deleting a %s that %s still reference fails with an error listing them. Like `deletion_protection`, it must be applied before the %s is destroyed. Defaults to `false`.", kind, holders, kind, holders, kind),
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+	}
+}
+
+// withoutID returns a copy of ids with every occurrence of id removed
+func withoutID(ids []string, id string) []string {
+	kept := make([]string, 0, len(ids))
+	for _, other := range ids {
+		if other != id {
+			kept = append(kept, other)
+		}
+	}
+	return kept
+}
+
+// describeObject formats an object for a diagnostic as its name and ID
+func describeObject(name, id string) string {
+	return fmt.Sprintf("%q (%s)", name, id)
+}
@@ -0,0 +1,36 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckReferences(t *testing.T) {
+	existing := map[string]bool{"contact1": true, "contact2": true}
+
+	ids := []types.String{
+		types.StringValue("contact1"),
+		types.StringValue("contcat2"),
+		types.StringUnknown(),
+		types.StringValue("contact3"),
+	}
+
+	diags := checkReferences(path.Root("contacts"), ids, existing, "contact")
+	require.Len(t, diags.Errors(), 2)
+
+	assert.Equal(t, "Unknown Contact", diags.Errors()[0].Summary())
+	assert.Contains(t, diags.Errors()[0].Detail(), `"contcat2"`)
+	assert.Contains(t, diags.Errors()[1].Detail(), `"contact3"`)
+
+	assert.False(t, checkReferences(path.Root("contacts"), ids[:1], existing, "contact").HasError())
+}
+
+func TestWithoutID(t *testing.T) {
+	assert.Equal(t, []string{"b"}, withoutID([]string{"a", "b", "a"}, "a"))
+	assert.Equal(t, []string{}, withoutID([]string{"a"}, "a"))
+	assert.Equal(t, []string{}, withoutID(nil, "a"))
+}
@@ -0,0 +1,101 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"terraform-provider-uptime/internal/client"
+)
+
+// monitorModel converts a monitor returned by the API into resource data,
+// with the provider-side flags at their defaults as after an import
+func monitorModel(ctx context.Context, monitor *client.Monitor) (MonitorResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var model MonitorResourceModel
+
+	if err := (&MonitorResource{}).apiModelToTerraformModel(ctx, monitor, &model); err != nil {
+		diags.AddError("Data Conversion Error", fmt.Sprintf("Unable to convert monitor %s: %s", monitor.ID, err))
+		return model, diags
+	}
+	model.DeletionProtection = types.BoolValue(false)
+	model.ForceDetach = types.BoolValue(false)
+
+	return model, diags
+}
+
+// contactModel converts a contact returned by the API into resource data,
+// with the provider-side flags at their defaults as after an import
+func contactModel(ctx context.Context, contact *client.Contact) (ContactResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var model ContactResourceModel
+
+	if err := (&ContactResource{}).contactToModel(ctx, contact, &model); err != nil {
+		diags.AddError("Data Conversion Error", fmt.Sprintf("Unable to parse details of contact %s: %s", contact.ID, err))
+		return model, diags
+	}
+	model.DeletionProtection = types.BoolValue(false)
+	model.ForceDetach = types.BoolValue(false)
+
+	return model, diags
+}
+
+// statusPageModel converts a status page returned by the API into resource
+// data, with the provider-side flags at their defaults as after an import
+func statusPageModel(ctx context.Context, statusPage *client.StatusPage) (StatusPageResourceModel, diag.Diagnostics) {
+	var model StatusPageResourceModel
+
+	diags := statusPageToModel(ctx, statusPage, &model)
+	model.DeletionProtection = types.BoolValue(false)
+
+	return model, diags
+}
+
+// MonitorState returns the Terraform state of a monitor returned by the API
+func MonitorState(ctx context.Context, monitor *client.Monitor) (tfsdk.State, diag.Diagnostics) {
+	model, diags := monitorModel(ctx, monitor)
+	if diags.HasError() {
+		return tfsdk.State{}, diags
+	}
+	return newState(ctx, NewMonitorResource(), &model)
+}
+
+// ContactState returns the Terraform state of a contact returned by the API
+func ContactState(ctx context.Context, contact *client.Contact) (tfsdk.State, diag.Diagnostics) {
+	model, diags := contactModel(ctx, contact)
+	if diags.HasError() {
+		return tfsdk.State{}, diags
+	}
+	return newState(ctx, NewContactResource(), &model)
+}
+
+// StatusPageState returns the Terraform state of a status page returned by
+// the API
+func StatusPageState(ctx context.Context, statusPage *client.StatusPage) (tfsdk.State, diag.Diagnostics) {
+	model, diags := statusPageModel(ctx, statusPage)
+	if diags.HasError() {
+		return tfsdk.State{}, diags
+	}
+	return newState(ctx, NewStatusPageResource(), &model)
+}
+
+// newState builds a state of resource r holding model
+func newState(ctx context.Context, r resource.Resource, model any) (tfsdk.State, diag.Diagnostics) {
+	var resp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &resp)
+	if resp.Diagnostics.HasError() {
+		return tfsdk.State{}, resp.Diagnostics
+	}
+
+	state := tfsdk.State{
+		Schema: resp.Schema,
+		Raw:    tftypes.NewValue(resp.Schema.Type().TerraformType(ctx), nil),
+	}
+	diags := state.Set(ctx, model)
+
+	return state, diags
+}
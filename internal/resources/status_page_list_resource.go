@@ -0,0 +1,108 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-uptime/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ list.ListResource = &StatusPageListResource{}
+var _ list.ListResourceWithConfigure = &StatusPageListResource{}
+
+func NewStatusPageListResource() list.ListResource {
+	return &StatusPageListResource{}
+}
+
+// StatusPageListResource lists existing status pages for `terraform query`.
+type StatusPageListResource struct {
+	client *client.Client
+}
+
+// StatusPageListResourceModel describes the list block configuration.
+type StatusPageListResourceModel struct {
+	Name types.String `tfsdk:"name"`
+}
+
+func (l *StatusPageListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_status_page"
+}
+
+func (l *StatusPageListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		MarkdownDescription: "Lists the status pages in the account, optionally filtered by name",
+
+		Attributes: map[string]listschema.Attribute{
+			"name": listschema.StringAttribute{
+				MarkdownDescription: "Only list status pages whose name contains this text, ignoring case",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (l *StatusPageListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	l.client = client
+}
+
+func (l *StatusPageListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data StatusPageListResourceModel
+
+	diags := req.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	statusPages, err := l.client.ListStatusPages()
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list status pages, got error: %s", err))
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	var matches []client.StatusPage
+	for _, statusPage := range statusPages {
+		if nameMatches(data.Name, statusPage.Name) {
+			matches = append(matches, statusPage)
+		}
+	}
+
+	stream.Results = listResults(ctx, req, matches, func(statusPage client.StatusPage, result *list.ListResult) {
+		result.DisplayName = statusPage.Name
+		result.Diagnostics.Append(setIdentity(ctx, result.Identity, types.StringValue(statusPage.ID))...)
+
+		if !req.IncludeResource {
+			return
+		}
+
+		model, diags := statusPageModel(ctx, &statusPage)
+		result.Diagnostics.Append(diags...)
+		if result.Diagnostics.HasError() {
+			return
+		}
+
+		result.Diagnostics.Append(result.Resource.Set(ctx, &model)...)
+	})
+}
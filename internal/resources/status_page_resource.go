@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -24,6 +25,8 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &StatusPageResource{}
 var _ resource.ResourceWithImportState = &StatusPageResource{}
+var _ resource.ResourceWithIdentity = &StatusPageResource{}
+var _ resource.ResourceWithModifyPlan = &StatusPageResource{}
 
 func NewStatusPageResource() resource.Resource {
 	return &StatusPageResource{}
@@ -45,6 +48,7 @@ type StatusPageResourceModel struct {
 	BasicAuth           types.String `tfsdk:"basic_auth"`
 	CreatedAt           types.Int64  `tfsdk:"created_at"`
 	URL                 types.String `tfsdk:"url"`
+	DeletionProtection  types.Bool   `tfsdk:"deletion_protection"`
 }
 
 func (r *StatusPageResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -114,6 +118,7 @@ func (r *StatusPageResource) Schema(ctx context.Context, req resource.SchemaRequ
 					),
 				},
 			},
+			"deletion_protection": deletionProtectionAttribute("status page"),
 			"created_at": schema.Int64Attribute{
 				Computed:            true,
 				MarkdownDescription: "Unix timestamp when the status page was created",
@@ -126,6 +131,10 @@ func (r *StatusPageResource) Schema(ctx context.Context, req resource.SchemaRequ
 	}
 }
 
+func (r *StatusPageResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("status page")
+}
+
 func (r *StatusPageResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider is not configured.
 	if req.ProviderData == nil {
@@ -146,6 +155,26 @@ func (r *StatusPageResource) Configure(ctx context.Context, req resource.Configu
 	r.client = client
 }
 
+func (r *StatusPageResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	checkReadOnly(r.client, "status page", req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Skip validation during resource destruction
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data StatusPageResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.validateMonitors(ctx, &data, resp)
+}
+
 func (r *StatusPageResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data StatusPageResourceModel
 
@@ -214,6 +243,7 @@ func (r *StatusPageResource) Create(ctx context.Context, req resource.CreateRequ
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, data.ID)...)
 }
 
 func (r *StatusPageResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -238,35 +268,15 @@ func (r *StatusPageResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 
 	// Update model with API data
-	data.Name = types.StringValue(statusPage.Name)
-	data.Period = types.Int64Value(int64(statusPage.Period))
-	data.ShowIncidentReasons = types.BoolValue(statusPage.ShowIncidentReasons)
-	data.CreatedAt = types.Int64Value(statusPage.CreatedAt)
-	data.URL = types.StringValue(statusPage.URL)
-
-	// Convert monitors to list
-	monitorList, diags := types.ListValueFrom(ctx, types.StringType, statusPage.Monitors)
-	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(statusPageToModel(ctx, statusPage, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	data.Monitors = monitorList
-
-	// Handle optional fields
-	if statusPage.CustomDomain != nil {
-		data.CustomDomain = types.StringValue(*statusPage.CustomDomain)
-	} else {
-		data.CustomDomain = types.StringNull()
-	}
-
-	if statusPage.BasicAuth != nil {
-		data.BasicAuth = types.StringValue(*statusPage.BasicAuth)
-	} else {
-		data.BasicAuth = types.StringNull()
-	}
+	data.DeletionProtection = stateFlagValue(data.DeletionProtection)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, data.ID)...)
 }
 
 func (r *StatusPageResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -344,6 +354,7 @@ func (r *StatusPageResource) Update(ctx context.Context, req resource.UpdateRequ
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, data.ID)...)
 }
 
 func (r *StatusPageResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -356,6 +367,11 @@ func (r *StatusPageResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
+	checkDeletionProtection(data.DeletionProtection, "status page", data.ID.ValueString(), resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Delete status page via API
 	err := r.client.DeleteStatusPage(data.ID.ValueString())
 	if err != nil {
@@ -364,8 +380,77 @@ func (r *StatusPageResource) Delete(ctx context.Context, req resource.DeleteRequ
 	}
 }
 
+// ImportState accepts a status page ID, an identity or a natural key made
+// of name and custom domain, e.g. `domain=status.example.com`
 func (r *StatusPageResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	key, err := parseImportID(req.ID, "name", "domain")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Unable to parse import ID %q: %s", req.ID, err))
+		return
+	}
+	if key == nil {
+		resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+		return
+	}
+
+	statusPages, err := r.client.ListStatusPages()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list status pages, got error: %s", err))
+		return
+	}
+
+	var matches []importMatch
+	for _, statusPage := range statusPages {
+		if name, ok := key["name"]; ok && statusPage.Name != name {
+			continue
+		}
+		if domain, ok := key["domain"]; ok && (statusPage.CustomDomain == nil || !strings.EqualFold(*statusPage.CustomDomain, domain)) {
+			continue
+		}
+		matches = append(matches, importMatch{ID: statusPage.ID, Name: statusPage.Name})
+	}
+
+	id, err := resolveImportMatch("status page", key, matches)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Import Status Page", fmt.Sprintf("Cannot resolve import ID %q: %s", req.ID, err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, types.StringValue(id))...)
+}
+
+// statusPageToModel copies a status page returned by the API into the
+// Terraform model
+func statusPageToModel(ctx context.Context, statusPage *client.StatusPage, data *StatusPageResourceModel) diag.Diagnostics {
+	data.ID = types.StringValue(statusPage.ID)
+	data.Name = types.StringValue(statusPage.Name)
+	data.Period = types.Int64Value(int64(statusPage.Period))
+	data.ShowIncidentReasons = types.BoolValue(statusPage.ShowIncidentReasons)
+	data.CreatedAt = types.Int64Value(statusPage.CreatedAt)
+	data.URL = types.StringValue(statusPage.URL)
+
+	// Convert monitors to list
+	monitorList, diags := types.ListValueFrom(ctx, types.StringType, statusPage.Monitors)
+	if diags.HasError() {
+		return diags
+	}
+	data.Monitors = monitorList
+
+	// Handle optional fields
+	if statusPage.CustomDomain != nil {
+		data.CustomDomain = types.StringValue(*statusPage.CustomDomain)
+	} else {
+		data.CustomDomain = types.StringNull()
+	}
+
+	if statusPage.BasicAuth != nil {
+		data.BasicAuth = types.StringValue(*statusPage.BasicAuth)
+	} else {
+		data.BasicAuth = types.StringNull()
+	}
+
+	return diags
 }
 
 // validateCustomDomain validates the custom domain according to backend rules
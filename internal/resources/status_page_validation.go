@@ -0,0 +1,39 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// validateMonitors checks that the planned monitors exist in the account
+func (r *StatusPageResource) validateMonitors(ctx context.Context, data *StatusPageResourceModel, resp *resource.ModifyPlanResponse) {
+	if r.client == nil || data.Monitors.IsNull() || data.Monitors.IsUnknown() {
+		return
+	}
+
+	var ids []types.String
+	resp.Diagnostics.Append(data.Monitors.ElementsAs(ctx, &ids, false)...)
+	if resp.Diagnostics.HasError() || len(ids) == 0 {
+		return
+	}
+
+	monitors, err := r.client.ListMonitorsCached()
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Unable to Validate Monitors",
+			fmt.Sprintf("Could not fetch the list of monitors, so monitors will only be checked by the API during apply: %s", err),
+		)
+		return
+	}
+
+	existing := make(map[string]bool, len(monitors))
+	for _, monitor := range monitors {
+		existing[monitor.ID] = true
+	}
+
+	resp.Diagnostics.Append(checkReferences(path.Root("monitors"), ids, existing, "monitor")...)
+}
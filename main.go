@@ -4,8 +4,10 @@ import (
 	"context"
 	"flag"
 	"log"
+	"os"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"terraform-provider-uptime/internal/cli"
 	"terraform-provider-uptime/internal/provider"
 )
 
@@ -30,6 +32,12 @@ var (
 )
 
 func main() {
+	// Subcommands such as "export" run against the account and exit;
+	// Terraform starts the binary without one to serve the provider.
+	if len(os.Args) > 1 && cli.IsCommand(os.Args[1]) {
+		os.Exit(cli.Run(context.Background(), os.Args[1:], os.Stdout, os.Stderr))
+	}
+
 	var debug bool
 
 	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")